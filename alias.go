@@ -0,0 +1,204 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+// An AliasTable samples indices in proportion to a list of weights in
+// constant time using Walker's alias method, built with Vose's stable
+// construction, "A Linear Algorithm For Generating Random Numbers With
+// a Given Distribution" (1991). Each of the n columns holds an index
+// and, in the remaining space, an alias. Sampling picks a column and
+// then flips a biased coin between the two.
+//
+// Construction uses integer arithmetic, so a table built from integer
+// weights samples each index with exactly its weight's share. Float
+// weights are first rounded to integers totaling about 2^62 / n, with
+// every positive weight rounded up to at least 1, so no positive
+// weight is dropped, though tiny ones are sampled more often than
+// their exact share.
+//
+// The zero value is an empty table, which must be built with Rebuild
+// or RebuildUint before sampling.
+type AliasTable struct {
+	total uint64 // capacity of each column
+	cells []aliasCell
+
+	// Scratch space kept between rebuilds.
+	weights []uint64
+	work    []int
+}
+
+type aliasCell struct {
+	prob  uint64 // share of the column kept by its own index
+	alias uint64 // index occupying the rest of the column
+}
+
+// NewAliasTable returns a table sampling each index of weights in
+// proportion to its weight. It panics under the same conditions as
+// Rebuild.
+func NewAliasTable(weights []float64) *AliasTable {
+	t := new(AliasTable)
+	t.Rebuild(weights)
+	return t
+}
+
+// NewAliasTableUint returns a table sampling each index of weights with
+// probability exactly weights[i] / sum(weights). It panics under the
+// same conditions as RebuildUint.
+func NewAliasTableUint(weights []uint64) *AliasTable {
+	t := new(AliasTable)
+	t.RebuildUint(weights)
+	return t
+}
+
+// Len returns the number of indices in the table.
+func (t *AliasTable) Len() int {
+	return len(t.cells)
+}
+
+// Rebuild replaces the table's weights, reusing its memory where
+// possible. It panics if weights is empty, if any weight is negative,
+// infinite, or NaN, or if all weights are zero.
+func (t *AliasTable) Rebuild(weights []float64) {
+	var max float64
+	for _, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 1) {
+			panic("invalid weight in AliasTable.Rebuild")
+		}
+		max = math.Max(max, w)
+	}
+	if max == 0 {
+		panic("no positive weights in AliasTable.Rebuild")
+	}
+	var sum float64
+	for _, w := range weights {
+		sum += w / max
+	}
+	scale := float64(uint64(1)<<62/uint64(len(weights))) / sum
+	iw := t.weights[:0]
+	for _, w := range weights {
+		v := uint64(math.Round(w / max * scale))
+		if v == 0 && w > 0 {
+			v = 1
+		}
+		iw = append(iw, v)
+	}
+	t.weights = iw
+	t.RebuildUint(iw)
+}
+
+// RebuildUint replaces the table's weights with exact integer weights,
+// reusing its memory where possible. It panics if weights is empty or
+// all zero, or if the sum of the weights times their number does not
+// fit in a uint64.
+func (t *AliasTable) RebuildUint(weights []uint64) {
+	n := uint64(len(weights))
+	var total, carry uint64
+	for _, w := range weights {
+		total, carry = bits.Add64(total, w, 0)
+		if carry != 0 {
+			panic("weights overflow in AliasTable.RebuildUint")
+		}
+	}
+	if total == 0 {
+		panic("no positive weights in AliasTable.RebuildUint")
+	}
+	if hi, _ := bits.Mul64(total, n); hi != 0 {
+		panic("weights overflow in AliasTable.RebuildUint")
+	}
+
+	if uint64(cap(t.cells)) < n {
+		t.cells = make([]aliasCell, n)
+	}
+	t.cells = t.cells[:n]
+	t.total = total
+
+	// Scaled by n, the weights are measured in the same units as the
+	// column capacity. Small (underfull) indices stack up from the
+	// front of work and large ones from the back.
+	if uint64(cap(t.work)) < n {
+		t.work = make([]int, n)
+	}
+	work := t.work[:n]
+	small, large := 0, len(work)
+	for i, w := range weights {
+		t.cells[i] = aliasCell{w * n, uint64(i)}
+		if w*n < total {
+			work[small] = i
+			small++
+		} else {
+			large--
+			work[large] = i
+		}
+	}
+	for small > 0 && large < len(work) {
+		small--
+		s := work[small]
+		l := work[large]
+		t.cells[s].alias = uint64(l)
+		t.cells[l].prob -= total - t.cells[s].prob
+		if t.cells[l].prob < total {
+			large++
+			work[small] = l
+			small++
+		}
+	}
+	// Exact arithmetic leaves only full columns, which keep themselves.
+	for _, i := range work[large:] {
+		t.cells[i].prob = total
+	}
+}
+
+// Sample returns a random index with probability proportional to its
+// weight. It panics if the table is empty.
+func (t *AliasTable) Sample(src rand.Source64) int {
+	i := uint64n(src, uint64(len(t.cells)))
+	c := t.cells[i]
+	if uint64n(src, t.total) < c.prob {
+		return int(i)
+	}
+	return int(c.alias)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using the same
+// framing as the generator states.
+func (t *AliasTable) MarshalBinary() ([]byte, error) {
+	w := make([]uint64, 1+2*len(t.cells))
+	w[0] = t.total
+	for i, c := range t.cells {
+		w[1+2*i] = c.prob
+		w[2+2*i] = c.alias
+	}
+	return marshalState("aliastable", w...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (t *AliasTable) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalWords("aliastable", b)
+	if err != nil {
+		return err
+	}
+	if len(w)%2 != 1 {
+		return errLength
+	}
+	n := uint64(len(w) / 2)
+	total := w[0]
+	if (total == 0) != (n == 0) {
+		return errInvalidState
+	}
+	cells := make([]aliasCell, n)
+	for i := range cells {
+		c := aliasCell{w[1+2*i], w[2+2*i]}
+		if c.prob > total || c.alias >= n {
+			return errInvalidState
+		}
+		cells[i] = c
+	}
+	t.total, t.cells = total, cells
+	return nil
+}
@@ -0,0 +1,189 @@
+package rng_test
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+// aliasMass decodes a serialized table and returns, for each index, its
+// total share of all columns in units of one column's capacity.
+func aliasMass(t *testing.T, a *rng.AliasTable) (mass []uint64, total uint64) {
+	t.Helper()
+	buf, _ := a.MarshalBinary()
+	buf = buf[2+int(buf[1]):]
+	total = binary.LittleEndian.Uint64(buf)
+	buf = buf[8:]
+	mass = make([]uint64, len(buf)/16)
+	for i := range mass {
+		prob := binary.LittleEndian.Uint64(buf[16*i:])
+		alias := binary.LittleEndian.Uint64(buf[16*i+8:])
+		mass[i] += prob
+		mass[alias] += total - prob
+	}
+	return
+}
+
+func checkAliasFrequencies(t *testing.T, a *rng.AliasTable, src rand.Source64, weights []float64) {
+	t.Helper()
+	const n = 500000
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+	var expected []float64
+	var bins []int
+	for _, w := range weights {
+		if w > 0 {
+			bins = append(bins, len(expected))
+			expected = append(expected, n*w/sum)
+		} else {
+			bins = append(bins, -1)
+		}
+	}
+	observed := make([]int, len(expected))
+	for i := 0; i < n; i++ {
+		k := a.Sample(src)
+		if bins[k] < 0 {
+			t.Fatalf("Sample(), got zero-weight index %d", k)
+		}
+		observed[bins[k]]++
+	}
+	df := len(expected) - 1
+	if x := chiSquare(observed, expected); !chiSquareOK(x, df) {
+		t.Errorf("Sample() chi-square, got %v with %d degrees of freedom",
+			x, df)
+	}
+}
+
+func TestAliasTableUint(t *testing.T) {
+	weights := []uint64{1, 0, 7, 3, 1000, 12, 0, 5, 5, 1 << 40}
+	a := rng.NewAliasTableUint(weights)
+	if a.Len() != len(weights) {
+		t.Errorf("Len(), got %d, want %d", a.Len(), len(weights))
+	}
+
+	// Every index owns exactly its share of the columns.
+	mass, total := aliasMass(t, a)
+	var sum uint64
+	for _, w := range weights {
+		sum += w
+	}
+	if total != sum {
+		t.Errorf("column capacity, got %d, want %d", total, sum)
+	}
+	for i, w := range weights {
+		if want := w * uint64(len(weights)); mass[i] != want {
+			t.Errorf("mass[%d], got %d, want %d", i, mass[i], want)
+		}
+	}
+
+	fw := []float64{3, 0, 1, 2, 9, 1, 1}
+	iw := make([]uint64, len(fw))
+	for i, w := range fw {
+		iw[i] = uint64(w)
+	}
+	a.RebuildUint(iw)
+	checkAliasFrequencies(t, a, rng.NewSfc64(1, 2, 3), fw)
+}
+
+func TestAliasTable(t *testing.T) {
+	src := rng.NewXoshiro256ss(1, 2, 3, 4)
+	weights := []float64{0.1, 0.25, 0, 1e-3, 0.5, 0.149}
+	a := rng.NewAliasTable(weights)
+	checkAliasFrequencies(t, a, src, weights)
+
+	// Rebuilding replaces the distribution entirely.
+	weights = make([]float64, 100)
+	for i := range weights {
+		weights[i] = float64(i % 7)
+	}
+	a.Rebuild(weights)
+	if a.Len() != len(weights) {
+		t.Errorf("Len(), got %d, want %d", a.Len(), len(weights))
+	}
+	checkAliasFrequencies(t, a, src, weights)
+
+	// Tiny positive weights are kept, not rounded away.
+	a.Rebuild([]float64{1, 1e-30})
+	if mass, _ := aliasMass(t, a); mass[1] == 0 {
+		t.Errorf("Rebuild() dropped a positive weight")
+	}
+
+	// Rebuilding at the same size reuses all memory.
+	allocs := testing.AllocsPerRun(10, func() {
+		a.Rebuild([]float64{3, 1e-30})
+	})
+	if allocs != 0 {
+		t.Errorf("Rebuild() allocated %v times", allocs)
+	}
+
+	// Huge weights do not overflow.
+	a.Rebuild([]float64{1e308, 1e308, 5e307})
+	checkAliasFrequencies(t, a, src, []float64{2, 2, 1})
+}
+
+func TestAliasTableMarshal(t *testing.T) {
+	a := rng.NewAliasTable([]float64{1, 2, 3, 4, 5})
+	buf, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b rng.AliasTable
+	if err := b.UnmarshalBinary(buf); err != nil {
+		t.Fatal(err)
+	}
+	r1 := rng.NewSplitMix64(1)
+	r2 := rng.NewSplitMix64(1)
+	for i := 0; i < 1000; i++ {
+		x, y := a.Sample(r1), b.Sample(r2)
+		if x != y {
+			t.Fatalf("resumed Sample(), got %d, want %d", y, x)
+		}
+	}
+
+	if err := b.UnmarshalBinary(buf[:len(buf)-8]); err == nil {
+		t.Errorf("UnmarshalBinary() accepted truncated table")
+	}
+	bad := append([]byte(nil), buf...)
+	bad[len(bad)-8] = 5 // alias out of range
+	if err := b.UnmarshalBinary(bad); err == nil {
+		t.Errorf("UnmarshalBinary() accepted out of range alias")
+	}
+	var x rng.Xoshiro256ss
+	if err := x.UnmarshalBinary(buf); err == nil {
+		t.Errorf("Xoshiro256ss.UnmarshalBinary() accepted a table")
+	}
+}
+
+func TestAliasTablePanics(t *testing.T) {
+	for name, f := range map[string]func(){
+		"empty":    func() { rng.NewAliasTable(nil) },
+		"zero":     func() { rng.NewAliasTable([]float64{0, 0}) },
+		"negative": func() { rng.NewAliasTable([]float64{1, -1}) },
+		"overflow": func() { rng.NewAliasTableUint([]uint64{1 << 63, 1 << 62}) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s weights did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func BenchmarkAliasTable(b *testing.B) {
+	weights := make([]float64, 1000)
+	for i := range weights {
+		weights[i] = float64(i + 1)
+	}
+	a := rng.NewAliasTable(weights)
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for i := 0; i < b.N; i++ {
+		a.Sample(r)
+	}
+}
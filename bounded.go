@@ -0,0 +1,74 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math/bits"
+	"math/rand"
+)
+
+// Uint64n returns a uniform pseudo-random number in [0, n) using
+// Lemire's nearly divisionless method: the high half of a 128-bit
+// product is the result, and the low half decides the rare rejections
+// needed to remove bias. A division happens only when a rejection is
+// possible. It panics if n == 0.
+//
+// Lemire, "Fast Random Integer Generation in an Interval" (2019).
+func Uint64n[G Generator](g G, n uint64) uint64 {
+	if n == 0 {
+		panic("invalid argument to Uint64n")
+	}
+	for {
+		if r, ok := lemire(g.Uint64(), n, 64); ok {
+			return r
+		}
+	}
+}
+
+// Uint32n returns a uniform pseudo-random number in [0, n) using
+// Lemire's method on the top 32 bits of each output. It panics if
+// n == 0.
+func Uint32n[G Generator](g G, n uint32) uint32 {
+	if n == 0 {
+		panic("invalid argument to Uint32n")
+	}
+	for {
+		if r, ok := lemire(g.Uint64()>>32, uint64(n), 32); ok {
+			return uint32(r)
+		}
+	}
+}
+
+// lemire is one step of Lemire's method for a w-bit word x and
+// 0 < n <= 2^w, with 0 < w <= 64. It returns the high w bits of the
+// 2w-bit product x*n, or false if x must be rejected. The division
+// runs only when the low w bits are below n, where rejection is
+// possible. Each result is produced by exactly floor(2^w / n) accepted
+// words, which small widths allow testing exhaustively; every bounded
+// function in this package goes through here.
+func lemire(x, n uint64, w uint) (uint64, bool) {
+	hi, lo := bits.Mul64(x<<(64-w), n)
+	lo >>= 64 - w
+	if lo < n && lo < (1<<w-n)%n {
+		return 0, false
+	}
+	return hi, true
+}
+
+// IntRange returns a uniform pseudo-random number in [lo, hi). It
+// panics if lo >= hi.
+func IntRange[G Generator](g G, lo, hi int) int {
+	if lo >= hi {
+		panic("invalid argument to IntRange")
+	}
+	return lo + int(Uint64n(g, uint64(hi)-uint64(lo)))
+}
+
+// uint64n is Uint64n for callers holding only a rand.Source64.
+func uint64n(src rand.Source64, n uint64) uint64 {
+	for {
+		if r, ok := lemire(src.Uint64(), n, 64); ok {
+			return r
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package rng_test
+
+import (
+	"math"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+// chiSquare returns the chi-square statistic of observed counts
+// against expected counts.
+func chiSquare(observed []int, expected []float64) float64 {
+	var x float64
+	for i, o := range observed {
+		d := float64(o) - expected[i]
+		x += d * d / expected[i]
+	}
+	return x
+}
+
+// chiSquareOK reports whether a statistic with df degrees of freedom
+// is below a generous (roughly 5 sigma) acceptance bound.
+func chiSquareOK(x float64, df int) bool {
+	return x <= float64(df)+5*math.Sqrt(2*float64(df))
+}
+
+func TestUint64n(t *testing.T) {
+	// Every small range is uniform.
+	r := rng.NewSfc64(1, 2, 3)
+	for n := 1; n <= 64; n++ {
+		samples := 2000 * n
+		counts := make([]int, n)
+		expected := make([]float64, n)
+		for i := range expected {
+			expected[i] = float64(samples) / float64(n)
+		}
+		for i := 0; i < samples; i++ {
+			v := rng.Uint64n(r, uint64(n))
+			if v >= uint64(n) {
+				t.Fatalf("Uint64n(%d), got %d", n, v)
+			}
+			counts[v]++
+		}
+		if x := chiSquare(counts, expected); !chiSquareOK(x, n-1) {
+			t.Errorf("Uint64n(%d) chi-square %.1f, counts %v",
+				n, x, counts)
+		}
+	}
+}
+
+func TestUint64nBias(t *testing.T) {
+	// With n = 2^64 * 2/3, modulo reduction would return values below
+	// 2^64 - n = n/2 two thirds of the time instead of half the
+	// time. Lemire's method must stay at one half.
+	const n = (1<<64 - 1) / 3 * 2
+	const samples = 1 << 16
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	var low int
+	for i := 0; i < samples; i++ {
+		if rng.Uint64n(r, n) < n/2 {
+			low++
+		}
+	}
+	counts := []int{low, samples - low}
+	expected := []float64{samples / 2, samples / 2}
+	if x := chiSquare(counts, expected); !chiSquareOK(x, 1) {
+		t.Errorf("Uint64n(%#x) biased, %d of %d below n/2",
+			uint64(n), low, samples)
+	}
+}
+
+func TestLemireExhaustive(t *testing.T) {
+	// Every w-bit word through the reduction that Uint64n, Uint32n,
+	// and the rest use at full width: each result must come from
+	// exactly the same number of accepted words.
+	for _, w := range []uint{1, 2, 3, 5, 8, 16} {
+		// All n up to 8 bits; for 16 bits, the small ones and the top.
+		var ns []uint64
+		for n := uint64(1); n <= 1<<w && n <= 512; n++ {
+			ns = append(ns, n)
+		}
+		if w == 16 {
+			ns = append(ns, 40000, 1<<w-1, 1<<w)
+		}
+		for _, n := range ns {
+			counts := make([]uint64, n)
+			for x := uint64(0); x < 1<<w; x++ {
+				if r, ok := rng.Lemire(x, n, w); ok {
+					if r >= n {
+						t.Fatalf("lemire(%d, %d, %d), got %d", x, n, w, r)
+					}
+					counts[r]++
+				}
+			}
+			want := (uint64(1) << w) / n
+			for r, c := range counts {
+				if c != want {
+					t.Errorf("lemire(w=%d, n=%d) result %d, got %d words, want %d",
+						w, n, r, c, want)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestUint32n(t *testing.T) {
+	r := rng.NewPcg64(1, 2)
+	for n := uint32(1); n <= 16; n++ {
+		counts := make([]int, n)
+		expected := make([]float64, n)
+		for i := range expected {
+			expected[i] = 1000
+		}
+		for i := 0; i < 1000*int(n); i++ {
+			counts[rng.Uint32n(r, n)]++
+		}
+		if x := chiSquare(counts, expected); !chiSquareOK(x, int(n)-1) {
+			t.Errorf("Uint32n(%d) chi-square %.1f", n, x)
+		}
+	}
+}
+
+func TestIntRange(t *testing.T) {
+	r := rng.NewSplitMix64(1)
+	seen := make(map[int]bool)
+	for i := 0; i < 1000; i++ {
+		v := rng.IntRange(r, -3, 4)
+		if v < -3 || v >= 4 {
+			t.Fatalf("IntRange(-3, 4), got %d", v)
+		}
+		seen[v] = true
+	}
+	if len(seen) != 7 {
+		t.Errorf("IntRange(-3, 4) produced %d distinct values", len(seen))
+	}
+
+	// The full range of int must not overflow.
+	for i := 0; i < 1000; i++ {
+		rng.IntRange(r, math.MinInt, math.MaxInt)
+	}
+}
+
+func BenchmarkUint64n(b *testing.B) {
+	r := rng.NewSfc64(uint64(b.N), 0, 0)
+	for i := 0; i < b.N; i++ {
+		rng.Uint64n(r, 1000)
+	}
+}
@@ -0,0 +1,235 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math"
+)
+
+// Poisson returns a Poisson distributed pseudo-random number with mean
+// lambda. Means below 10 use Knuth's multiplication method, and larger
+// means use the transformed rejection method with squeeze (PTRS) of
+// Hörmann, "The transformed rejection method for generating Poisson
+// random variables" (1993), whose cost does not grow with lambda. It
+// panics if lambda is negative or above 2^62.
+func Poisson[G Generator](g G, lambda float64) int64 {
+	if !(lambda >= 0) || lambda > 1<<62 {
+		panic("invalid argument to Poisson")
+	}
+	if lambda >= 10 {
+		return poissonPTRS(g, lambda)
+	}
+	limit := math.Exp(-lambda)
+	var k int64
+	for p := UnitFloat64(g); p > limit; p *= UnitFloat64(g) {
+		k++
+	}
+	return k
+}
+
+func poissonPTRS[G Generator](g G, lambda float64) int64 {
+	slam := math.Sqrt(lambda)
+	loglam := math.Log(lambda)
+	b := 0.931 + 2.53*slam
+	a := -0.059 + 0.02483*b
+	invalpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+	for {
+		u := UnitFloat64(g) - 0.5
+		v := OpenFloat64(g)
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + lambda + 0.43)
+		if us >= 0.07 && v <= vr {
+			return int64(k)
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(invalpha)-math.Log(a/(us*us)+b) <=
+			-lambda+k*loglam-lg {
+			return int64(k)
+		}
+	}
+}
+
+// Binomial returns a binomially distributed pseudo-random number: the
+// number of successes in n independent trials, each succeeding with
+// probability p. When n*min(p, 1-p) is below 30 it uses inversion, and
+// otherwise the BTPE algorithm of Kachitvichyanukul and Schmeiser,
+// "Binomial Random Variate Generation" (1988). It panics if n is
+// negative or p is outside [0, 1].
+func Binomial[G Generator](g G, n int64, p float64) int64 {
+	if n < 0 || !(p >= 0 && p <= 1) {
+		panic("invalid argument to Binomial")
+	}
+	r := p
+	if p > 0.5 {
+		r = 1 - p
+	}
+	var y int64
+	switch {
+	case n == 0 || r == 0:
+		y = 0
+	case float64(n)*r < 30:
+		y = binomialInversion(g, n, r)
+	default:
+		y = binomialBTPE(g, n, r)
+	}
+	if p > 0.5 {
+		return n - y
+	}
+	return y
+}
+
+// binomialInversion samples Binomial(n, p) for p <= 0.5 by a
+// sequential search of the distribution function.
+func binomialInversion[G Generator](g G, n int64, p float64) int64 {
+	q := 1 - p
+	qn := math.Exp(float64(n) * math.Log1p(-p))
+	np := float64(n) * p
+	bound := math.Min(float64(n), np+10*math.Sqrt(np*q+1))
+	var x int64
+	px := qn
+	u := UnitFloat64(g)
+	for u > px {
+		x++
+		if float64(x) > bound {
+			// Lost to rounding in the far tail: start over.
+			x = 0
+			px = qn
+			u = UnitFloat64(g)
+		} else {
+			u -= px
+			px = float64(n-x+1) * p * px / (float64(x) * q)
+		}
+	}
+	return x
+}
+
+// binomialBTPE samples Binomial(n, p) for p <= 0.5 and n*p >= 30.
+func binomialBTPE[G Generator](g G, n int64, p float64) int64 {
+	fn := float64(n)
+	q := 1 - p
+	nrq := fn * p * q
+	fm := fn*p + p
+	m := math.Floor(fm)
+	p1 := math.Floor(2.195*math.Sqrt(nrq)-4.6*q) + 0.5
+	xm := m + 0.5
+	xl := xm - p1
+	xr := xm + p1
+	c := 0.134 + 20.5/(15.3+m)
+	a := (fm - xl) / (fm - xl*p)
+	laml := a * (1 + a/2)
+	a = (xr - fm) / (xr * q)
+	lamr := a * (1 + a/2)
+	p2 := p1 * (1 + 2*c)
+	p3 := p2 + c/laml
+	p4 := p3 + c/lamr
+
+	for {
+		u := UnitFloat64(g) * p4
+		v := UnitFloat64(g)
+		var y float64
+		switch {
+		case u <= p1:
+			// Triangular center: always accepted.
+			return int64(math.Floor(xm - p1*v + u))
+		case u <= p2:
+			// Parallelograms.
+			x := xl + (u-p1)/c
+			v = v*c + 1 - math.Abs(m-x+0.5)/p1
+			if v > 1 {
+				continue
+			}
+			y = math.Floor(x)
+		case u <= p3:
+			// Left exponential tail.
+			if v == 0 {
+				continue
+			}
+			y = math.Floor(xl + math.Log(v)/laml)
+			if y < 0 {
+				continue
+			}
+			v *= (u - p2) * laml
+		default:
+			// Right exponential tail.
+			if v == 0 {
+				continue
+			}
+			y = math.Floor(xr - math.Log(v)/lamr)
+			if y > fn {
+				continue
+			}
+			v *= (u - p3) * lamr
+		}
+		if btpeAccept(y, m, fn, p, q, nrq, xm, v) {
+			return int64(y)
+		}
+	}
+}
+
+// btpeAccept performs the final BTPE acceptance test of candidate y
+// against v, evaluating the density ratio f(y)/f(m) either exactly by
+// recursion or, far from the mode m, with squeezes and Stirling's
+// approximation.
+func btpeAccept(y, m, n, p, q, nrq, xm, v float64) bool {
+	k := math.Abs(y - m)
+	if k <= 20 || k >= nrq/2-1 {
+		s := p / q
+		a := s * (n + 1)
+		f := 1.0
+		for i := m + 1; i <= y; i++ {
+			f *= a/i - s
+		}
+		for i := y + 1; i <= m; i++ {
+			f /= a/i - s
+		}
+		return v <= f
+	}
+
+	rho := (k / nrq) * ((k*(k/3+0.625)+1.0/6)/nrq + 0.5)
+	t := -k * k / (2 * nrq)
+	lv := math.Log(v)
+	if lv < t-rho {
+		return true
+	}
+	if lv > t+rho {
+		return false
+	}
+
+	x1 := y + 1
+	f1 := m + 1
+	z := n + 1 - m
+	w := n - y + 1
+	return lv <= xm*math.Log(f1/x1)+
+		(n-m+0.5)*math.Log(z/w)+
+		(y-m)*math.Log(w*p/(x1*q))+
+		stirlingTail(f1)+stirlingTail(z)+stirlingTail(x1)+stirlingTail(w)
+}
+
+// stirlingTail returns the correction term of Stirling's formula for
+// log(x!), accurate for the large arguments BTPE supplies.
+func stirlingTail(x float64) float64 {
+	x2 := x * x
+	return (13860 - (462-(132-(99-140/x2)/x2)/x2)/x2) / x / 166320
+}
+
+// Geometric returns a geometrically distributed pseudo-random number:
+// the number of failures before the first success in independent
+// trials, each succeeding with probability p. Results too large for an
+// int64 saturate at math.MaxInt64. It panics if p is outside (0, 1].
+func Geometric[G Generator](g G, p float64) int64 {
+	if !(p > 0 && p <= 1) {
+		panic("invalid argument to Geometric")
+	}
+	if p == 1 {
+		return 0
+	}
+	k := math.Floor(math.Log(OpenFloat64(g)) / math.Log1p(-p))
+	if k >= 1<<63 {
+		return math.MaxInt64
+	}
+	return int64(k)
+}
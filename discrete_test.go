@@ -0,0 +1,173 @@
+package rng_test
+
+import (
+	"math"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+// checkDiscrete draws samples and runs a chi-square test against pmf
+// over [lo, hi], which must hold nearly all of the mass. Neighboring
+// values are pooled until each bin expects at least 10 samples, and
+// samples outside [lo, hi] count toward the edge bins.
+func checkDiscrete(t *testing.T, name string, sample func() int64,
+	pmf func(int64) float64, lo, hi int64) {
+	t.Helper()
+	const n = 200000
+	var expected []float64
+	bin := make([]int, hi-lo+1)
+	var acc float64
+	for k := lo; k <= hi; k++ {
+		acc += n * pmf(k)
+		bin[k-lo] = len(expected)
+		if acc >= 10 {
+			expected = append(expected, acc)
+			acc = 0
+		}
+	}
+	if len(expected) == 0 {
+		expected = append(expected, 0)
+	}
+	expected[len(expected)-1] += acc
+	for k := range bin {
+		if bin[k] == len(expected) {
+			bin[k]--
+		}
+	}
+
+	observed := make([]int, len(expected))
+	for i := 0; i < n; i++ {
+		k := sample()
+		switch {
+		case k < lo:
+			k = lo
+		case k > hi:
+			k = hi
+		}
+		observed[bin[k-lo]]++
+	}
+	df := len(expected) - 1
+	if x := chiSquare(observed, expected); !chiSquareOK(x, df) {
+		t.Errorf("%s chi-square, got %v with %d degrees of freedom",
+			name, x, df)
+	}
+}
+
+func poissonPmf(lambda float64) func(int64) float64 {
+	return func(k int64) float64 {
+		lg, _ := math.Lgamma(float64(k) + 1)
+		return math.Exp(float64(k)*math.Log(lambda) - lambda - lg)
+	}
+}
+
+func binomialPmf(n int64, p float64) func(int64) float64 {
+	return func(k int64) float64 {
+		ln, _ := math.Lgamma(float64(n) + 1)
+		lk, _ := math.Lgamma(float64(k) + 1)
+		lnk, _ := math.Lgamma(float64(n-k) + 1)
+		return math.Exp(ln - lk - lnk +
+			float64(k)*math.Log(p) + float64(n-k)*math.Log1p(-p))
+	}
+}
+
+func TestPoisson(t *testing.T) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for _, lambda := range []float64{0.1, 1, 4.5, 9.99, 10, 37.5, 1000, 1e6} {
+		sd := math.Sqrt(lambda)
+		lo := int64(math.Max(0, lambda-8*sd))
+		hi := int64(lambda + 8*sd + 10)
+		checkDiscrete(t, "Poisson",
+			func() int64 { return rng.Poisson(r, lambda) },
+			poissonPmf(lambda), lo, hi)
+	}
+
+	if k := rng.Poisson(r, 0); k != 0 {
+		t.Errorf("Poisson(0), got %v", k)
+	}
+
+	// Huge means are too wide to bin; check the first two moments.
+	const lambda = 1e15
+	const n = 10000
+	var sum, sum2 float64
+	for i := 0; i < n; i++ {
+		d := float64(rng.Poisson(r, lambda)) - lambda
+		sum += d
+		sum2 += d * d
+	}
+	if m := sum / n; math.Abs(m) > 5*math.Sqrt(lambda/n) {
+		t.Errorf("Poisson(%v) mean offset, got %v", lambda, m)
+	}
+	if v := sum2 / n; math.Abs(v/lambda-1) > 5*math.Sqrt(2.0/n) {
+		t.Errorf("Poisson(%v) variance, got %v", lambda, v)
+	}
+}
+
+func TestBinomial(t *testing.T) {
+	r := rng.NewSfc64(1, 2, 3)
+	cases := []struct {
+		n int64
+		p float64
+	}{
+		{1, 0.5}, {10, 0.3}, {59, 0.5}, {100, 0.7}, {61, 0.5},
+		{1000, 0.1}, {1000, 0.95}, {100000, 0.4}, {1 << 40, 1e-9},
+	}
+	for _, c := range cases {
+		mean := float64(c.n) * c.p
+		sd := math.Sqrt(mean * (1 - c.p))
+		lo := int64(math.Max(0, mean-8*sd))
+		hi := int64(math.Min(float64(c.n), mean+8*sd+10))
+		checkDiscrete(t, "Binomial",
+			func() int64 { return rng.Binomial(r, c.n, c.p) },
+			binomialPmf(c.n, c.p), lo, hi)
+	}
+
+	if k := rng.Binomial(r, 10, 0); k != 0 {
+		t.Errorf("Binomial(10, 0), got %v", k)
+	}
+	if k := rng.Binomial(r, 10, 1); k != 10 {
+		t.Errorf("Binomial(10, 1), got %v", k)
+	}
+}
+
+func TestGeometric(t *testing.T) {
+	r := rng.NewPcg64(1, 2)
+	for _, p := range []float64{0.9, 0.5, 0.1, 0.001} {
+		hi := int64(math.Ceil(30 / p))
+		checkDiscrete(t, "Geometric",
+			func() int64 { return rng.Geometric(r, p) },
+			func(k int64) float64 {
+				return p * math.Exp(float64(k)*math.Log1p(-p))
+			}, 0, hi)
+	}
+
+	if k := rng.Geometric(r, 1); k != 0 {
+		t.Errorf("Geometric(1), got %v", k)
+	}
+	for i := 0; i < 1000; i++ {
+		if k := rng.Geometric(r, 1e-300); k < 0 {
+			t.Fatalf("Geometric(1e-300), got %v", k)
+		}
+	}
+}
+
+func BenchmarkPoissonSmall(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for i := 0; i < b.N; i++ {
+		rng.Poisson(r, 4)
+	}
+}
+
+func BenchmarkPoissonLarge(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for i := 0; i < b.N; i++ {
+		rng.Poisson(r, 1e6)
+	}
+}
+
+func BenchmarkBinomial(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for i := 0; i < b.N; i++ {
+		rng.Binomial(r, 100000, 0.4)
+	}
+}
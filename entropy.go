@@ -0,0 +1,358 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// The New*FromEntropy constructors seed from the operating system's
+// entropy source, and the New*FromReader constructors from any reader,
+// such as a deterministic one in tests. Seeds go through SeedUint64s,
+// so invalid states are never produced.
+
+// readWords reads n little-endian words from r.
+func readWords(r io.Reader, n int) ([]uint64, error) {
+	buf := make([]byte, 8*n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, fmt.Errorf("rng: failed to read seed: %w", err)
+	}
+	key := make([]uint64, n)
+	for i := range key {
+		key[i] = binary.LittleEndian.Uint64(buf[i*8:])
+	}
+	return key, nil
+}
+
+// entropyWords returns n words from the operating system's entropy
+// source, panicking if it fails.
+func entropyWords(n int) []uint64 {
+	key, err := readWords(rand.Reader, n)
+	if err != nil {
+		panic(err.Error())
+	}
+	return key
+}
+
+// NewLcg128FromEntropy returns a new Lcg128 seeded from the operating
+// system's entropy source.
+func NewLcg128FromEntropy() *Lcg128 {
+	s := new(Lcg128)
+	s.SeedUint64s(entropyWords(2)...)
+	return s
+}
+
+// NewLcg128FromReader returns a new Lcg128 seeded with 16 bytes read
+// from r, or an error if r fails.
+func NewLcg128FromReader(r io.Reader) (*Lcg128, error) {
+	key, err := readWords(r, 2)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Lcg128)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewSplitMix64FromEntropy returns a new SplitMix64 seeded from the
+// operating system's entropy source.
+func NewSplitMix64FromEntropy() *SplitMix64 {
+	s := new(SplitMix64)
+	s.SeedUint64s(entropyWords(1)...)
+	return s
+}
+
+// NewSplitMix64FromReader returns a new SplitMix64 seeded with 8 bytes
+// read from r, or an error if r fails.
+func NewSplitMix64FromReader(r io.Reader) (*SplitMix64, error) {
+	key, err := readWords(r, 1)
+	if err != nil {
+		return nil, err
+	}
+	s := new(SplitMix64)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewSplittableRandomFromEntropy returns a new SplittableRandom seeded
+// from the operating system's entropy source.
+func NewSplittableRandomFromEntropy() *SplittableRandom {
+	s := new(SplittableRandom)
+	s.SeedUint64s(entropyWords(2)...)
+	return s
+}
+
+// NewSplittableRandomFromReader returns a new SplittableRandom seeded
+// with 16 bytes read from r, or an error if r fails.
+func NewSplittableRandomFromReader(r io.Reader) (*SplittableRandom, error) {
+	key, err := readWords(r, 2)
+	if err != nil {
+		return nil, err
+	}
+	s := new(SplittableRandom)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewXoshiro256ssFromEntropy returns a new Xoshiro256ss seeded from the
+// operating system's entropy source.
+func NewXoshiro256ssFromEntropy() *Xoshiro256ss {
+	s := new(Xoshiro256ss)
+	s.SeedUint64s(entropyWords(4)...)
+	return s
+}
+
+// NewXoshiro256ssFromReader returns a new Xoshiro256ss seeded with 32
+// bytes read from r, or an error if r fails.
+func NewXoshiro256ssFromReader(r io.Reader) (*Xoshiro256ss, error) {
+	key, err := readWords(r, 4)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Xoshiro256ss)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewPcg32FromEntropy returns a new Pcg32 seeded from the operating
+// system's entropy source.
+func NewPcg32FromEntropy() *Pcg32 {
+	s := new(Pcg32)
+	s.SeedUint64s(entropyWords(1)...)
+	return s
+}
+
+// NewPcg32FromReader returns a new Pcg32 seeded with 8 bytes read from
+// r, or an error if r fails.
+func NewPcg32FromReader(r io.Reader) (*Pcg32, error) {
+	key, err := readWords(r, 1)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Pcg32)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewPcg32StreamFromEntropy returns a new Pcg32Stream seeded from the
+// operating system's entropy source.
+func NewPcg32StreamFromEntropy() *Pcg32Stream {
+	s := new(Pcg32Stream)
+	s.SeedUint64s(entropyWords(2)...)
+	return s
+}
+
+// NewPcg32StreamFromReader returns a new Pcg32Stream seeded with 16
+// bytes read from r, or an error if r fails.
+func NewPcg32StreamFromReader(r io.Reader) (*Pcg32Stream, error) {
+	key, err := readWords(r, 2)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Pcg32Stream)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewPcg64FromEntropy returns a new Pcg64 seeded from the operating
+// system's entropy source.
+func NewPcg64FromEntropy() *Pcg64 {
+	s := new(Pcg64)
+	s.SeedUint64s(entropyWords(2)...)
+	return s
+}
+
+// NewPcg64FromReader returns a new Pcg64 seeded with 16 bytes read from
+// r, or an error if r fails.
+func NewPcg64FromReader(r io.Reader) (*Pcg64, error) {
+	key, err := readWords(r, 2)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Pcg64)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewPcg64StreamFromEntropy returns a new Pcg64Stream seeded from the
+// operating system's entropy source.
+func NewPcg64StreamFromEntropy() *Pcg64Stream {
+	s := new(Pcg64Stream)
+	s.SeedUint64s(entropyWords(4)...)
+	return s
+}
+
+// NewPcg64StreamFromReader returns a new Pcg64Stream seeded with 32
+// bytes read from r, or an error if r fails.
+func NewPcg64StreamFromReader(r io.Reader) (*Pcg64Stream, error) {
+	key, err := readWords(r, 4)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Pcg64Stream)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewPcg64DxsmFromEntropy returns a new Pcg64Dxsm seeded from the
+// operating system's entropy source.
+func NewPcg64DxsmFromEntropy() *Pcg64Dxsm {
+	s := new(Pcg64Dxsm)
+	s.SeedUint64s(entropyWords(4)...)
+	return s
+}
+
+// NewPcg64DxsmFromReader returns a new Pcg64Dxsm seeded with 32 bytes
+// read from r, or an error if r fails.
+func NewPcg64DxsmFromReader(r io.Reader) (*Pcg64Dxsm, error) {
+	key, err := readWords(r, 4)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Pcg64Dxsm)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewPcg64xFromEntropy returns a new Pcg64x seeded from the operating
+// system's entropy source.
+func NewPcg64xFromEntropy() *Pcg64x {
+	s := new(Pcg64x)
+	s.SeedUint64s(entropyWords(2)...)
+	return s
+}
+
+// NewPcg64xFromReader returns a new Pcg64x seeded with 16 bytes read
+// from r, or an error if r fails.
+func NewPcg64xFromReader(r io.Reader) (*Pcg64x, error) {
+	key, err := readWords(r, 2)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Pcg64x)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewMsws64FromEntropy returns a new Msws64 seeded from the operating
+// system's entropy source.
+func NewMsws64FromEntropy() *Msws64 {
+	s := new(Msws64)
+	s.SeedUint64s(entropyWords(4)...)
+	return s
+}
+
+// NewMsws64FromReader returns a new Msws64 seeded with 32 bytes read
+// from r, or an error if r fails.
+func NewMsws64FromReader(r io.Reader) (*Msws64, error) {
+	key, err := readWords(r, 4)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Msws64)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewRomuDuoFromEntropy returns a new RomuDuo seeded from the operating
+// system's entropy source.
+func NewRomuDuoFromEntropy() *RomuDuo {
+	s := new(RomuDuo)
+	s.SeedUint64s(entropyWords(2)...)
+	return s
+}
+
+// NewRomuDuoFromReader returns a new RomuDuo seeded with 16 bytes read
+// from r, or an error if r fails.
+func NewRomuDuoFromReader(r io.Reader) (*RomuDuo, error) {
+	key, err := readWords(r, 2)
+	if err != nil {
+		return nil, err
+	}
+	s := new(RomuDuo)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewRomuDuoJrFromEntropy returns a new RomuDuoJr seeded from the
+// operating system's entropy source.
+func NewRomuDuoJrFromEntropy() *RomuDuoJr {
+	s := new(RomuDuoJr)
+	s.SeedUint64s(entropyWords(2)...)
+	return s
+}
+
+// NewRomuDuoJrFromReader returns a new RomuDuoJr seeded with 16 bytes
+// read from r, or an error if r fails.
+func NewRomuDuoJrFromReader(r io.Reader) (*RomuDuoJr, error) {
+	key, err := readWords(r, 2)
+	if err != nil {
+		return nil, err
+	}
+	s := new(RomuDuoJr)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewMmlfgFromEntropy returns a new Mmlfg seeded from the operating
+// system's entropy source.
+func NewMmlfgFromEntropy() *Mmlfg {
+	s := new(Mmlfg)
+	s.SeedUint64s(entropyWords(15)...)
+	return s
+}
+
+// NewMmlfgFromReader returns a new Mmlfg seeded with 120 bytes read
+// from r, or an error if r fails.
+func NewMmlfgFromReader(r io.Reader) (*Mmlfg, error) {
+	key, err := readWords(r, 15)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Mmlfg)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewMwc256xxa64FromEntropy returns a new Mwc256xxa64 seeded from the
+// operating system's entropy source.
+func NewMwc256xxa64FromEntropy() *Mwc256xxa64 {
+	s := new(Mwc256xxa64)
+	s.SeedUint64s(entropyWords(4)...)
+	return s
+}
+
+// NewMwc256xxa64FromReader returns a new Mwc256xxa64 seeded with 32
+// bytes read from r, or an error if r fails.
+func NewMwc256xxa64FromReader(r io.Reader) (*Mwc256xxa64, error) {
+	key, err := readWords(r, 4)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Mwc256xxa64)
+	s.SeedUint64s(key...)
+	return s, nil
+}
+
+// NewSfc64FromEntropy returns a new Sfc64 seeded from the operating
+// system's entropy source.
+func NewSfc64FromEntropy() *Sfc64 {
+	s := new(Sfc64)
+	s.SeedUint64s(entropyWords(4)...)
+	return s
+}
+
+// NewSfc64FromReader returns a new Sfc64 seeded with 32 bytes read from
+// r, or an error if r fails.
+func NewSfc64FromReader(r io.Reader) (*Sfc64, error) {
+	key, err := readWords(r, 4)
+	if err != nil {
+		return nil, err
+	}
+	s := new(Sfc64)
+	s.SeedUint64s(key...)
+	return s, nil
+}
@@ -0,0 +1,49 @@
+package rng_test
+
+import (
+	"bytes"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestFromReader(t *testing.T) {
+
+	// A deterministic reader gives deterministic generators.
+	seed := bytes.Repeat([]byte{0xa5}, 1024)
+	a, err := rng.NewSfc64FromReader(bytes.NewReader(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := rng.NewSfc64FromReader(bytes.NewReader(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *a != *b {
+		t.Errorf("NewSfc64FromReader(), got %#x, want %#x", *b, *a)
+	}
+
+	// Even an all-zero source must yield valid states.
+	zero := make([]byte, 1024)
+	x, err := rng.NewXoshiro256ssFromReader(bytes.NewReader(zero))
+	if err != nil || *x == (rng.Xoshiro256ss{}) {
+		t.Errorf("NewXoshiro256ssFromReader() is all zero, %v", err)
+	}
+	m, err := rng.NewMwc256xxa64FromReader(bytes.NewReader(zero))
+	if err != nil || *m == (rng.Mwc256xxa64{}) {
+		t.Errorf("NewMwc256xxa64FromReader() is all zero, %v", err)
+	}
+
+	// A short source is an error rather than a poor seed.
+	if _, err := rng.NewRomuDuoFromReader(bytes.NewReader(seed[:15])); err == nil {
+		t.Errorf("NewRomuDuoFromReader() accepted a short seed")
+	}
+}
+
+func TestFromEntropy(t *testing.T) {
+	a := rng.NewXoshiro256ssFromEntropy()
+	b := rng.NewXoshiro256ssFromEntropy()
+	if *a == *b {
+		t.Errorf("NewXoshiro256ssFromEntropy() returned equal states")
+	}
+}
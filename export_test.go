@@ -0,0 +1,11 @@
+package rng
+
+// Lemire exposes lemire to the external tests.
+var Lemire = lemire
+
+// The portable math functions, exposed for their known-answer tests.
+var (
+	PortableLog   = portableLog
+	PortableLog1p = portableLog1p
+	PortableExp   = portableExp
+)
@@ -0,0 +1,397 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math/bits"
+)
+
+// The Fill methods produce exactly the same sequence as repeated calls
+// to Uint64(), but keep the generator state in local variables for the
+// whole loop, avoiding per-call overhead. FillUint32 splits each 64-bit
+// output into two values, low half first, which matches the byte
+// stream produced by Reader; when dst has odd length, the high half of
+// the final output is discarded. The 32-bit generators, Pcg32 and
+// Pcg32Stream, instead step once per value, exactly like Uint32(), so
+// nothing is discarded. FillFloat64 produces uniform values in [0, 1)
+// from the top 53 bits of each output.
+
+// fillChunk is the number of outputs buffered by FillUint32 and
+// FillFloat64 between Fill calls.
+const fillChunk = 64
+
+func fillUint32(fill func([]uint64), dst []uint32) {
+	var buf [fillChunk]uint64
+	for len(dst) > 0 {
+		n := (len(dst) + 1) / 2
+		if n > fillChunk {
+			n = fillChunk
+		}
+		fill(buf[:n])
+		for _, v := range buf[:n] {
+			dst[0] = uint32(v)
+			if len(dst) == 1 {
+				return
+			}
+			dst[1] = uint32(v >> 32)
+			dst = dst[2:]
+		}
+	}
+}
+
+func fillFloat64(fill func([]uint64), dst []float64) {
+	var buf [fillChunk]uint64
+	for len(dst) > 0 {
+		n := len(dst)
+		if n > fillChunk {
+			n = fillChunk
+		}
+		fill(buf[:n])
+		for i, v := range buf[:n] {
+			dst[i] = float64(v>>11) * 0x1p-53
+		}
+		dst = dst[n:]
+	}
+}
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Lcg128) Fill(dst []uint64) {
+	hi, lo := s.Hi, s.Lo
+	for i := range dst {
+		carry, l := bits.Mul64(lcg128Mlo, lo)
+		h := lcg128Mhi*lo + hi*lcg128Mlo + carry
+		l, carry = bits.Add64(l, lcg128Mlo, 0)
+		h += lcg128Mhi + carry
+		hi, lo = h, l
+		dst[i] = h
+	}
+	s.Hi, s.Lo = hi, lo
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *Lcg128) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Lcg128) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *SplitMix64) Fill(dst []uint64) {
+	x := uint64(*s)
+	for i := range dst {
+		x += goldenGamma
+		dst[i] = mix64(x)
+	}
+	*s = SplitMix64(x)
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *SplitMix64) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *SplitMix64) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *SplittableRandom) Fill(dst []uint64) {
+	x, g := s.State, s.Gamma
+	for i := range dst {
+		x += g
+		dst[i] = mix64(x)
+	}
+	s.State = x
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *SplittableRandom) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *SplittableRandom) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Xoshiro256ss) Fill(dst []uint64) {
+	s0, s1, s2, s3 := s[0], s[1], s[2], s[3]
+	for i := range dst {
+		dst[i] = bits.RotateLeft64(s1*5, 7) * 9
+		t := s1 << 17
+		s2 ^= s0
+		s3 ^= s1
+		s1 ^= s2
+		s0 ^= s3
+		s2 ^= t
+		s3 = bits.RotateLeft64(s3, 45)
+	}
+	s[0], s[1], s[2], s[3] = s0, s1, s2, s3
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *Xoshiro256ss) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Xoshiro256ss) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Pcg32) Fill(dst []uint64) {
+	p := uint64(*s)
+	for i := range dst {
+		lo := bits.RotateLeft32(uint32((p>>18^p)>>27), -int(p>>59))
+		p = p*pcg32M + pcg32A
+		hi := bits.RotateLeft32(uint32((p>>18^p)>>27), -int(p>>59))
+		p = p*pcg32M + pcg32A
+		dst[i] = uint64(hi)<<32 | uint64(lo)
+	}
+	*s = Pcg32(p)
+}
+
+// FillUint32 fills dst with successive outputs of Uint32().
+func (s *Pcg32) FillUint32(dst []uint32) {
+	p := uint64(*s)
+	for i := range dst {
+		dst[i] = bits.RotateLeft32(uint32((p>>18^p)>>27), -int(p>>59))
+		p = p*pcg32M + pcg32A
+	}
+	*s = Pcg32(p)
+}
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Pcg32) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Pcg32Stream) Fill(dst []uint64) {
+	p, inc := s.State, s.Inc|1
+	for i := range dst {
+		lo := bits.RotateLeft32(uint32((p>>18^p)>>27), -int(p>>59))
+		p = p*pcg32M + inc
+		hi := bits.RotateLeft32(uint32((p>>18^p)>>27), -int(p>>59))
+		p = p*pcg32M + inc
+		dst[i] = uint64(hi)<<32 | uint64(lo)
+	}
+	s.State = p
+}
+
+// FillUint32 fills dst with successive outputs of Uint32().
+func (s *Pcg32Stream) FillUint32(dst []uint32) {
+	p, inc := s.State, s.Inc|1
+	for i := range dst {
+		dst[i] = bits.RotateLeft32(uint32((p>>18^p)>>27), -int(p>>59))
+		p = p*pcg32M + inc
+	}
+	s.State = p
+}
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Pcg32Stream) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Pcg64) Fill(dst []uint64) {
+	hi, lo := s.Hi, s.Lo
+	for i := range dst {
+		carry, l := bits.Mul64(pcg64Mlo, lo)
+		h := pcg64Mhi*lo + hi*pcg64Mlo + carry
+		l, carry = bits.Add64(l, pcg64Alo, 0)
+		h += pcg64Ahi + carry
+		hi, lo = h, l
+		dst[i] = pcg64Output(hi, lo)
+	}
+	s.Hi, s.Lo = hi, lo
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *Pcg64) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Pcg64) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Pcg64Stream) Fill(dst []uint64) {
+	hi, lo, ahi, alo := s.Hi, s.Lo, s.IncHi, s.IncLo|1
+	for i := range dst {
+		carry, l := bits.Mul64(pcg64Mlo, lo)
+		h := pcg64Mhi*lo + hi*pcg64Mlo + carry
+		l, carry = bits.Add64(l, alo, 0)
+		h += ahi + carry
+		hi, lo = h, l
+		dst[i] = bits.RotateLeft64(hi^lo, -int(hi>>58))
+	}
+	s.Hi, s.Lo = hi, lo
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *Pcg64Stream) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Pcg64Stream) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Pcg64Dxsm) Fill(dst []uint64) {
+	hi, lo, ahi, alo := s.Hi, s.Lo, s.IncHi, s.IncLo|1
+	for i := range dst {
+		r := hi
+		r ^= r >> 32
+		r *= pcg64CheapM
+		r ^= r >> 48
+		dst[i] = r * (lo | 1)
+		carry, l := bits.Mul64(lo, pcg64CheapM)
+		h := hi*pcg64CheapM + carry
+		l, carry = bits.Add64(l, alo, 0)
+		hi, lo = h+ahi+carry, l
+	}
+	s.Hi, s.Lo = hi, lo
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *Pcg64Dxsm) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Pcg64Dxsm) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Pcg64x) Fill(dst []uint64) {
+	hi, lo := s.Hi, s.Lo
+	for i := range dst {
+		var c uint64
+		c, lo = bits.Mul64(lo, pcg64xM)
+		hi = hi*pcg64xM + c
+		lo, c = bits.Add64(lo, 1, 0)
+		hi += c
+		dst[i] = pcg64xOutput(hi)
+	}
+	s.Hi, s.Lo = hi, lo
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *Pcg64x) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Pcg64x) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Msws64) Fill(dst []uint64) {
+	x0, x1, w0, w1 := s[0], s[1], s[2], s[3]
+	for i := range dst {
+		var xl, xh, c uint64
+		c, xl = bits.Mul64(x0, x0)
+		xh = 2*x0*x1 + c
+		w0, c = bits.Add64(w0, msws64Klo, 0)
+		w1 += msws64Khi + c
+		xl, c = bits.Add64(xl, w0, 0)
+		xh += w1 + c
+		x0, x1 = xh, xl
+		dst[i] = xh
+	}
+	s[0], s[1], s[2], s[3] = x0, x1, w0, w1
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *Msws64) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Msws64) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *RomuDuo) Fill(dst []uint64) {
+	x, y := s.x, s.y
+	for i := range dst {
+		dst[i] = x
+		x, y = 0xd3833e804f4c574b*y,
+			bits.RotateLeft64(y, 36)+bits.RotateLeft64(y, 15)-x
+	}
+	s.x, s.y = x, y
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *RomuDuo) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *RomuDuo) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *RomuDuoJr) Fill(dst []uint64) {
+	x, y := s.x, s.y
+	for i := range dst {
+		dst[i] = x
+		x, y = 0xd3833e804f4c574b*y, bits.RotateLeft64(y-x, 27)
+	}
+	s.x, s.y = x, y
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *RomuDuoJr) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *RomuDuoJr) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (m *Mmlfg) Fill(dst []uint64) {
+	i, j := m.i, m.j
+	for k := range dst {
+		hi, lo := bits.Mul64(m.s[i], m.s[j])
+		m.s[i] = lo
+		if i--; i < 0 {
+			i = 14
+		}
+		if j--; j < 0 {
+			j = 14
+		}
+		dst[k] = hi<<32 | lo>>32
+	}
+	m.i, m.j = i, j
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (m *Mmlfg) FillUint32(dst []uint32) { fillUint32(m.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (m *Mmlfg) FillFloat64(dst []float64) { fillFloat64(m.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (m *Mwc256xxa64) Fill(dst []uint64) {
+	x0, x1, x2, c := m[0], m[1], m[2], m[3]
+	for i := range dst {
+		hi, lo := bits.Mul64(mwc256xxa64A, x2)
+		dst[i] = (x2 ^ x1) + (x0 ^ hi)
+		var carry uint64
+		x2, x1 = x1, x0
+		x0, carry = bits.Add64(c, lo, 0)
+		c = hi + carry
+	}
+	m[0], m[1], m[2], m[3] = x0, x1, x2, c
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (m *Mwc256xxa64) FillUint32(dst []uint32) { fillUint32(m.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (m *Mwc256xxa64) FillFloat64(dst []float64) { fillFloat64(m.Fill, dst) }
+
+// Fill fills dst with successive outputs of Uint64().
+func (s *Sfc64) Fill(dst []uint64) {
+	a, b, c, n := s[0], s[1], s[2], s[3]
+	for i := range dst {
+		r := a + b + n
+		n++
+		a = b>>11 ^ b
+		b = c<<3 + c
+		c = r + bits.RotateLeft64(c, 24)
+		dst[i] = r
+	}
+	s[0], s[1], s[2], s[3] = a, b, c, n
+}
+
+// FillUint32 fills dst with 32-bit halves of successive outputs. With
+// odd len(dst), the high half of the last output is discarded.
+func (s *Sfc64) FillUint32(dst []uint32) { fillUint32(s.Fill, dst) }
+
+// FillFloat64 fills dst with uniform values in [0, 1).
+func (s *Sfc64) FillFloat64(dst []float64) { fillFloat64(s.Fill, dst) }
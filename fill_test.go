@@ -0,0 +1,102 @@
+package rng_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+type filler interface {
+	marshaler
+	Fill([]uint64)
+	FillUint32([]uint32)
+	FillFloat64([]float64)
+}
+
+func TestFill(t *testing.T) {
+	const n = 201 // odd, and more than one internal chunk
+	for name, gen := range generators() {
+		if _, ok := gen().(filler); !ok {
+			t.Errorf("%s does not implement filler", name)
+			continue
+		}
+
+		// Fill must match Uint64() exactly, and leave the same state.
+		a, b := gen().(filler), gen()
+		got := make([]uint64, n)
+		a.Fill(got)
+		for i, g := range got {
+			if w := b.Uint64(); g != w {
+				t.Errorf("%s.Fill()[%d], got %#016x, want %#016x",
+					name, i, g, w)
+			}
+		}
+		if a.Uint64() != b.Uint64() {
+			t.Errorf("%s.Fill() left the wrong state", name)
+		}
+
+		// FillUint32 matches the little-endian Reader stream.
+		u32 := make([]uint32, n)
+		d := gen().(filler)
+		d.FillUint32(u32)
+		buf := make([]byte, 4*n)
+		io.ReadFull(rng.NewReader(gen()), buf)
+		want := make([]byte, 4*n)
+		for i, v := range u32 {
+			binary.LittleEndian.PutUint32(want[4*i:], v)
+		}
+		if !bytes.Equal(buf, want) {
+			t.Errorf("%s.FillUint32() does not match Reader", name)
+		}
+		if rng.Registry[name].OutputBits == 64 {
+			// The odd final high half was discarded.
+			e := gen()
+			for i := 0; i < (n+1)/2; i++ {
+				e.Uint64()
+			}
+			if d.Uint64() != e.Uint64() {
+				t.Errorf("%s.FillUint32() left the wrong state", name)
+			}
+		}
+
+		f64 := make([]float64, n)
+		gen().(filler).FillFloat64(f64)
+		c := gen()
+		for i, f := range f64 {
+			w := float64(c.Uint64()>>11) / (1 << 53)
+			if f != w || f < 0 || f >= 1 {
+				t.Errorf("%s.FillFloat64()[%d], got %v, want %v",
+					name, i, f, w)
+			}
+		}
+	}
+}
+
+func TestFillUint32Pcg32(t *testing.T) {
+	// The 32-bit generators match Uint32() exactly, even for odd
+	// lengths, and leave the same state.
+	const n = 7
+	a, b := rng.NewPcg32(1), rng.NewPcg32(1)
+	c := rng.NewPcg32Stream(1, 2)
+	d := rng.NewPcg32Stream(1, 2)
+	for round := 0; round < 3; round++ {
+		got := make([]uint32, n)
+		a.FillUint32(got)
+		for i, g := range got {
+			if w := b.Uint32(); g != w {
+				t.Errorf("Pcg32.FillUint32()[%d], got %#08x, want %#08x",
+					i, g, w)
+			}
+		}
+		c.FillUint32(got)
+		for i, g := range got {
+			if w := d.Uint32(); g != w {
+				t.Errorf("Pcg32Stream.FillUint32()[%d], got %#08x, want %#08x",
+					i, g, w)
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math"
+	"math/bits"
+)
+
+// UnitFloat64 returns a uniform pseudo-random number in [0, 1) from the
+// top 53 bits of one output, so all results are multiples of 2^-53.
+func UnitFloat64[G Generator](g G) float64 {
+	return float64(g.Uint64()>>11) * 0x1p-53
+}
+
+// UnitFloat32 returns a uniform pseudo-random number in [0, 1) from the
+// top 24 bits of one output, so all results are multiples of 2^-24.
+func UnitFloat32[G Generator](g G) float32 {
+	return float32(g.Uint64()>>40) * 0x1p-24
+}
+
+// OpenFloat64 returns a uniform pseudo-random number in (0, 1). The
+// results are the midpoints of 2^52 equal subintervals, so it is safe
+// to pass directly to math.Log.
+func OpenFloat64[G Generator](g G) float64 {
+	return (float64(g.Uint64()>>12) + 0.5) * 0x1p-52
+}
+
+// ClosedFloat64 returns a uniform pseudo-random number in [0, 1],
+// choosing uniformly among the 2^53 + 1 multiples of 2^-53 in that
+// interval.
+func ClosedFloat64[G Generator](g G) float64 {
+	return float64(Uint64n(g, 1<<53+1)) * 0x1p-53
+}
+
+// FullFloat64 returns a uniform pseudo-random number in [0, 1) where
+// every representable double may occur, each with probability equal to
+// its distance to the next double. The exponent is drawn geometrically
+// from the leading zero bits of as many outputs as needed, and the
+// mantissa from one more output, so small results keep full precision.
+// Results below 2^-1022, which occur with probability 2^-1022, are
+// rounded to the nearest subnormal.
+func FullFloat64[G Generator](g G) float64 {
+	e := 0 // leading zero bits
+	x := g.Uint64()
+	for x == 0 {
+		if e += 64; e >= 1074 {
+			return 0
+		}
+		x = g.Uint64()
+	}
+	e += bits.LeadingZeros64(x)
+	m := g.Uint64() >> 12
+	if e <= 1021 {
+		return math.Float64frombits(uint64(1022-e)<<52 | m)
+	}
+	return math.Ldexp(float64(1<<52|m), -e-53)
+}
+
+// FullFloat32 is the float32 equivalent of FullFloat64.
+func FullFloat32[G Generator](g G) float32 {
+	e := 0 // leading zero bits
+	x := g.Uint64()
+	for x == 0 {
+		if e += 64; e >= 149 {
+			return 0
+		}
+		x = g.Uint64()
+	}
+	e += bits.LeadingZeros64(x)
+	m := uint32(g.Uint64() >> 41)
+	if e <= 125 {
+		return math.Float32frombits(uint32(126-e)<<23 | m)
+	}
+	return float32(math.Ldexp(float64(1<<23|m), -e-24))
+}
@@ -0,0 +1,84 @@
+package rng_test
+
+import (
+	"math"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestUnitFloat(t *testing.T) {
+	a := rng.NewSfc64(1, 2, 3)
+	b := rng.NewSfc64(1, 2, 3)
+	for i := 0; i < 1000; i++ {
+		got := rng.UnitFloat64(a)
+		want := float64(b.Uint64()>>11) / (1 << 53)
+		if got != want {
+			t.Errorf("UnitFloat64(), got %v, want %v", got, want)
+		}
+		f := rng.UnitFloat32(a)
+		b.Uint64()
+		if f < 0 || f >= 1 {
+			t.Errorf("UnitFloat32(), got %v", f)
+		}
+	}
+}
+
+func TestOpenClosedFloat64(t *testing.T) {
+	r := rng.NewSplitMix64(0)
+	for i := 0; i < 100000; i++ {
+		if f := rng.OpenFloat64(r); f <= 0 || f >= 1 {
+			t.Fatalf("OpenFloat64(), got %v", f)
+		}
+		if f := rng.ClosedFloat64(r); f < 0 || f > 1 {
+			t.Fatalf("ClosedFloat64(), got %v", f)
+		}
+	}
+
+	// The extreme outputs of OpenFloat64 are symmetric.
+	lo := (0 + 0.5) * 0x1p-52
+	hi := (float64(1<<52-1) + 0.5) * 0x1p-52
+	if lo <= 0 || hi >= 1 || 1-hi != lo {
+		t.Errorf("OpenFloat64() extremes %v and %v", lo, hi)
+	}
+}
+
+func TestFullFloat64(t *testing.T) {
+	// Each quarter of [0, 1) is equally likely.
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	const samples = 1 << 16
+	counts := make([]int, 4)
+	var fine int
+	for i := 0; i < samples; i++ {
+		f := rng.FullFloat64(r)
+		if f < 0 || f >= 1 {
+			t.Fatalf("FullFloat64(), got %v", f)
+		}
+		counts[int(f*4)]++
+
+		// Small results have precision that UnitFloat64 lacks.
+		if f < 0x1p-8 && math.Float64bits(f)&0xff != 0 {
+			fine++
+		}
+	}
+	expected := []float64{samples / 4, samples / 4, samples / 4, samples / 4}
+	if x := chiSquare(counts, expected); !chiSquareOK(x, 3) {
+		t.Errorf("FullFloat64() quarters %v", counts)
+	}
+	if fine == 0 {
+		t.Errorf("FullFloat64() small results lack full precision")
+	}
+
+	for i := 0; i < samples; i++ {
+		if f := rng.FullFloat32(r); f < 0 || f >= 1 {
+			t.Fatalf("FullFloat32(), got %v", f)
+		}
+	}
+}
+
+func BenchmarkFullFloat64(b *testing.B) {
+	r := rng.NewSfc64(uint64(b.N), 0, 0)
+	for i := 0; i < b.N; i++ {
+		rng.FullFloat64(r)
+	}
+}
@@ -0,0 +1,109 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math"
+)
+
+// Gamma returns a gamma distributed pseudo-random number with the given
+// shape and scale (mean shape*scale). It uses the squeeze method of
+// Marsaglia and Tsang, "A Simple Method for Generating Gamma Variables"
+// (2000), boosting shapes below 1 with a uniform power. It panics if
+// shape or scale is not positive.
+func Gamma[G Generator](g G, shape, scale float64) float64 {
+	if !(shape > 0) || !(scale > 0) {
+		panic("invalid argument to Gamma")
+	}
+	return stdGamma(g, shape) * scale
+}
+
+// stdGamma returns a standard gamma variate (scale 1).
+func stdGamma[G Generator](g G, shape float64) float64 {
+	if shape >= 1 {
+		return gamma1(g, shape)
+	}
+	return math.Exp(logGamma(g, shape))
+}
+
+// logGamma returns the logarithm of a standard gamma variate with the
+// given shape. Working with logarithms keeps tiny shapes, whose
+// samples underflow, useful for ratios such as Beta.
+func logGamma[G Generator](g G, shape float64) float64 {
+	if shape < 1 {
+		// If X ~ Gamma(a+1) and U ~ Uniform(0, 1), then X*U^(1/a) is
+		// distributed Gamma(a).
+		u := OpenFloat64(g)
+		return math.Log(gamma1(g, shape+1)) + math.Log(u)/shape
+	}
+	return math.Log(gamma1(g, shape))
+}
+
+// gamma1 returns a standard gamma variate for shape >= 1.
+func gamma1[G Generator](g G, shape float64) float64 {
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := Normal(g)
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := OpenFloat64(g)
+		x2 := x * x
+		if u < 1-0.0331*x2*x2 {
+			return d * v
+		}
+		if math.Log(u) < x2/2+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
+
+// Beta returns a beta distributed pseudo-random number in [0, 1] with
+// shape parameters a and b, computed as X/(X+Y) from X ~ Gamma(a) and
+// Y ~ Gamma(b). It panics if a or b is not positive.
+func Beta[G Generator](g G, a, b float64) float64 {
+	if !(a > 0) || !(b > 0) {
+		panic("invalid argument to Beta")
+	}
+	if a >= 1 && b >= 1 {
+		x := gamma1(g, a)
+		y := gamma1(g, b)
+		return x / (x + y)
+	}
+	x := logGamma(g, a)
+	y := logGamma(g, b)
+	return 1 / (1 + math.Exp(y-x))
+}
+
+// ChiSquared returns a chi-squared distributed pseudo-random number
+// with k degrees of freedom. It panics if k is not positive.
+func ChiSquared[G Generator](g G, k float64) float64 {
+	if !(k > 0) {
+		panic("invalid argument to ChiSquared")
+	}
+	return 2 * stdGamma(g, k/2)
+}
+
+// StudentT returns a pseudo-random number from Student's t-distribution
+// with n degrees of freedom. It panics if n is not positive.
+func StudentT[G Generator](g G, n float64) float64 {
+	if !(n > 0) {
+		panic("invalid argument to StudentT")
+	}
+	z := Normal(g)
+	return z / math.Sqrt(ChiSquared(g, n)/n)
+}
+
+// FisherF returns a pseudo-random number from the F-distribution with
+// d1 and d2 degrees of freedom. It panics if d1 or d2 is not positive.
+func FisherF[G Generator](g G, d1, d2 float64) float64 {
+	if !(d1 > 0) || !(d2 > 0) {
+		panic("invalid argument to FisherF")
+	}
+	x := logGamma(g, d1/2)
+	y := logGamma(g, d2/2)
+	return math.Exp(x-y) * d2 / d1
+}
@@ -0,0 +1,216 @@
+package rng_test
+
+import (
+	"math"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+// regGammaP returns the regularized lower incomplete gamma function
+// P(a, x), following Numerical Recipes.
+func regGammaP(a, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	lg, _ := math.Lgamma(a)
+	if x < a+1 {
+		sum, del := 1/a, 1/a
+		for n := 1.0; n < 1000; n++ {
+			del *= x / (a + n)
+			sum += del
+			if math.Abs(del) < math.Abs(sum)*1e-15 {
+				break
+			}
+		}
+		return sum * math.Exp(-x+a*math.Log(x)-lg)
+	}
+	// Continued fraction for Q(a, x) by the modified Lentz method.
+	b := x + 1 - a
+	c := 1 / 1e-300
+	d := 1 / b
+	h := d
+	for i := 1.0; i < 1000; i++ {
+		an := -i * (i - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < 1e-300 {
+			d = 1e-300
+		}
+		c = b + an/c
+		if math.Abs(c) < 1e-300 {
+			c = 1e-300
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < 1e-15 {
+			break
+		}
+	}
+	return 1 - math.Exp(-x+a*math.Log(x)-lg)*h
+}
+
+// regBetaI returns the regularized incomplete beta function I_x(a, b),
+// following Numerical Recipes.
+func regBetaI(a, b, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return 1
+	case x > (a+1)/(a+b+2):
+		return 1 - regBetaI(b, a, 1-x)
+	}
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+	front := math.Exp(lab - la - lb + a*math.Log(x) + b*math.Log1p(-x))
+
+	c := 1.0
+	d := 1 - (a+b)*x/(a+1)
+	if math.Abs(d) < 1e-300 {
+		d = 1e-300
+	}
+	d = 1 / d
+	h := d
+	for m := 1.0; m < 1000; m++ {
+		for _, num := range [2]float64{
+			m * (b - m) * x / ((a + 2*m - 1) * (a + 2*m)),
+			-(a + m) * (a + b + m) * x / ((a + 2*m) * (a + 2*m + 1)),
+		} {
+			d = 1 + num*d
+			if math.Abs(d) < 1e-300 {
+				d = 1e-300
+			}
+			c = 1 + num/c
+			if math.Abs(c) < 1e-300 {
+				c = 1e-300
+			}
+			d = 1 / d
+			h *= d * c
+		}
+		if math.Abs(d*c-1) < 1e-15 {
+			break
+		}
+	}
+	return front * h / a
+}
+
+// checkContinuous draws n samples, then checks their mean against
+// mean (skipped if variance is infinite) and their distribution
+// against cdf.
+func checkContinuous(t *testing.T, name string, sample func() float64,
+	cdf func(float64) float64, mean, variance float64) {
+	t.Helper()
+	const n = 200000
+	samples := make([]float64, n)
+	var sum float64
+	for i := range samples {
+		x := sample()
+		if math.IsNaN(x) {
+			t.Fatalf("%s, got NaN", name)
+		}
+		samples[i] = x
+		sum += x
+	}
+	if !math.IsInf(variance, 0) {
+		if m := sum / n; math.Abs(m-mean) > 5*math.Sqrt(variance/n) {
+			t.Errorf("%s mean, got %v, want %v", name, m, mean)
+		}
+	}
+	if d := ksStatistic(samples, cdf); !ksOK(d, n) {
+		t.Errorf("%s KS statistic, got %v", name, d)
+	}
+}
+
+func TestGamma(t *testing.T) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for _, shape := range []float64{0.1, 0.5, 1, 2.5, 30} {
+		const scale = 2
+		checkContinuous(t, "Gamma",
+			func() float64 { return rng.Gamma(r, shape, scale) },
+			func(x float64) float64 { return regGammaP(shape, x/scale) },
+			shape*scale, shape*scale*scale)
+	}
+}
+
+func TestBeta(t *testing.T) {
+	r := rng.NewSfc64(1, 2, 3)
+	for _, p := range [][2]float64{{0.5, 0.5}, {2, 5}, {0.2, 3}, {10, 10}} {
+		a, b := p[0], p[1]
+		checkContinuous(t, "Beta",
+			func() float64 { return rng.Beta(r, a, b) },
+			func(x float64) float64 { return regBetaI(a, b, x) },
+			a/(a+b), a*b/((a+b)*(a+b)*(a+b+1)))
+	}
+
+	// Tiny shapes underflow both gammas, but the ratio stays valid.
+	for i := 0; i < 10000; i++ {
+		if x := rng.Beta(r, 0.001, 0.001); !(x >= 0 && x <= 1) {
+			t.Fatalf("Beta(0.001, 0.001), got %v", x)
+		}
+	}
+}
+
+func TestChiSquared(t *testing.T) {
+	r := rng.NewPcg64(1, 2)
+	for _, k := range []float64{1, 3, 10} {
+		checkContinuous(t, "ChiSquared",
+			func() float64 { return rng.ChiSquared(r, k) },
+			func(x float64) float64 { return regGammaP(k/2, x/2) },
+			k, 2*k)
+	}
+}
+
+func TestStudentT(t *testing.T) {
+	r := rng.NewRomuDuo(1, 2)
+	for _, n := range []float64{1, 2.5, 8} {
+		variance := math.Inf(1)
+		if n > 2 {
+			variance = n / (n - 2)
+		}
+		checkContinuous(t, "StudentT",
+			func() float64 { return rng.StudentT(r, n) },
+			func(x float64) float64 {
+				p := regBetaI(n/2, 0.5, n/(n+x*x)) / 2
+				if x > 0 {
+					return 1 - p
+				}
+				return p
+			},
+			0, variance)
+	}
+}
+
+func TestFisherF(t *testing.T) {
+	r := rng.NewMsws64(1, 2, 3, 4)
+	for _, p := range [][2]float64{{1, 1}, {3, 7}, {20, 30}} {
+		d1, d2 := p[0], p[1]
+		variance := math.Inf(1)
+		if d2 > 4 {
+			variance = 2 * d2 * d2 * (d1 + d2 - 2) /
+				(d1 * (d2 - 2) * (d2 - 2) * (d2 - 4))
+		}
+		checkContinuous(t, "FisherF",
+			func() float64 { return rng.FisherF(r, d1, d2) },
+			func(x float64) float64 {
+				return regBetaI(d1/2, d2/2, d1*x/(d1*x+d2))
+			},
+			d2/(d2-2), variance)
+	}
+}
+
+func BenchmarkGamma(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for i := 0; i < b.N; i++ {
+		rng.Gamma(r, 2.5, 1)
+	}
+}
+
+func BenchmarkBeta(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for i := 0; i < b.N; i++ {
+		rng.Beta(r, 2, 5)
+	}
+}
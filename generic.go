@@ -0,0 +1,234 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math"
+)
+
+//go:generate go run genmethods.go
+
+// A Generator is a pointer to one of the concrete generators in this
+// package. Functions constrained by Generator take the generator
+// directly, with no math/rand.Rand to allocate and no Source to
+// assert, and reject other sources at compile time. They are not
+// faster, though: gc reaches the methods of a type parameter through
+// a dictionary, so each call on g is still an indirect call. Where
+// speed matters, use the methods of the same names that every
+// generator also has (see methods.go), which call Int63 directly and
+// inline it where it is small enough.
+type Generator interface {
+	*Lcg128 | *SplitMix64 | *SplittableRandom | *Xoshiro256ss |
+		*Pcg32 | *Pcg32Stream | *Pcg64 | *Pcg64Stream | *Pcg64Dxsm |
+		*Pcg64x | *Msws64 | *RomuDuo | *RomuDuoJr | *Mmlfg |
+		*Mwc256xxa64 | *Sfc64
+	Uint64() uint64
+	Int63() int64
+}
+
+// The following mirror the methods of math/rand.Rand and, given the
+// same generator state, return exactly the same results, as do the
+// generated methods. Uint32 has no method form because Pcg32 and
+// Pcg32Stream already use that name for their native output.
+
+// Int63 returns a non-negative pseudo-random 63-bit integer.
+func Int63[G Generator](g G) int64 {
+	return g.Int63()
+}
+
+// Uint32 returns a pseudo-random 32-bit value.
+func Uint32[G Generator](g G) uint32 {
+	return uint32(g.Int63() >> 31)
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func Int31[G Generator](g G) int32 {
+	return int32(g.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func Int[G Generator](g G) int {
+	u := uint(g.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func Int63n[G Generator](g G, n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return g.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := g.Int63()
+	for v > max {
+		v = g.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func Int31n[G Generator](g G, n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return Int31(g) & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := Int31(g)
+	for v > max {
+		v = Int31(g)
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func Intn[G Generator](g G, n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(Int31n(g, int32(n)))
+	}
+	return int(Int63n(g, int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func Float64[G Generator](g G) float64 {
+again:
+	f := float64(g.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func Float32[G Generator](g G) float32 {
+again:
+	f := float32(Float64(g))
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// The NormFloat64 and ExpFloat64 ziggurat tables of math/rand, rebuilt
+// with the setup routine from Marsaglia and Tsang's paper, which is how
+// the originals were generated.
+var (
+	randKn [128]uint32
+	randWn [128]float32
+	randFn [128]float32
+	randKe [256]uint32
+	randWe [256]float32
+	randFe [256]float32
+)
+
+const (
+	randRn = 3.442619855899
+	randRe = 7.69711747013104972
+)
+
+func init() {
+	const m1 = 1 << 31
+	dn, tn, vn := 3.442619855899, 3.442619855899, 9.91256303526217e-3
+	q := vn / math.Exp(-.5*dn*dn)
+	randKn[0] = uint32((dn / q) * m1)
+	randWn[0] = float32(q / m1)
+	randWn[127] = float32(dn / m1)
+	randFn[0] = 1
+	randFn[127] = float32(math.Exp(-.5 * dn * dn))
+	for i := 126; i >= 1; i-- {
+		dn = math.Sqrt(-2 * math.Log(vn/dn+math.Exp(-.5*dn*dn)))
+		randKn[i+1] = uint32((dn / tn) * m1)
+		tn = dn
+		randFn[i] = float32(math.Exp(-.5 * dn * dn))
+		randWn[i] = float32(dn / m1)
+	}
+
+	const m2 = 1 << 32
+	de, te, ve := 7.697117470131487, 7.697117470131487, 3.949659822581572e-3
+	q = ve / math.Exp(-de)
+	randKe[0] = uint32((de / q) * m2)
+	randWe[0] = float32(q / m2)
+	randWe[255] = float32(de / m2)
+	randFe[0] = 1
+	randFe[255] = float32(math.Exp(-de))
+	for i := 254; i >= 1; i-- {
+		de = -math.Log(ve/de + math.Exp(-de))
+		randKe[i+1] = uint32((de / te) * m2)
+		te = de
+		randFe[i] = float32(math.Exp(-de))
+		randWe[i] = float32(de / m2)
+	}
+}
+
+// NormFloat64 returns a normally distributed float64 in the range
+// [-math.MaxFloat64, +math.MaxFloat64] with standard normal distribution
+// (mean = 0, stddev = 1).
+func NormFloat64[G Generator](g G) float64 {
+	for {
+		j := int32(Uint32(g)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(func() float64 { return Float64(g) }, j)
+		}
+		if randFn[i]+float32(Float64(g))*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// normTail samples the normal tail beyond randRn on the side given by
+// the sign of j.
+func normTail(uniform func() float64, j int32) float64 {
+	var x float64
+	for {
+		x = -math.Log(uniform()) * (1 / randRn)
+		y := -math.Log(uniform())
+		if y+y >= x*x {
+			break
+		}
+	}
+	if j > 0 {
+		return randRn + x
+	}
+	return -randRn - x
+}
+
+func absInt32(i int32) uint32 {
+	if i < 0 {
+		return uint32(-i)
+	}
+	return uint32(i)
+}
+
+// ExpFloat64 returns an exponentially distributed float64 in the range
+// (0, +math.MaxFloat64] with an exponential distribution whose rate
+// parameter (lambda) is 1 and whose mean is 1/lambda (1).
+func ExpFloat64[G Generator](g G) float64 {
+	for {
+		j := Uint32(g)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(Float64(g))
+		}
+		if randFe[i]+float32(Float64(g))*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package rng_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestGeneric(t *testing.T) {
+	// Every helper must agree exactly with math/rand.Rand.
+	a := rng.NewSfc64(1, 2, 3)
+	r := rand.New(rng.NewSfc64(1, 2, 3))
+	for i := 0; i < 1000; i++ {
+		ns := []int64{1, 2, 3, 10, 1 << 20, 1<<31 - 1, 1 << 31, 1<<62 + 1}
+		n := ns[i%len(ns)]
+		if n <= math.MaxInt {
+			if got, want := rng.Intn(a, int(n)), r.Intn(int(n)); got != want {
+				t.Errorf("Intn(%d), got %d, want %d", n, got, want)
+			}
+		}
+		if got, want := rng.Int63n(a, n), r.Int63n(n); got != want {
+			t.Errorf("Int63n(%d), got %d, want %d", n, got, want)
+		}
+		if n <= 1<<31-1 {
+			m := int32(n)
+			if got, want := rng.Int31n(a, m), r.Int31n(m); got != want {
+				t.Errorf("Int31n(%d), got %d, want %d", m, got, want)
+			}
+		}
+		if got, want := rng.Float64(a), r.Float64(); got != want {
+			t.Errorf("Float64(), got %v, want %v", got, want)
+		}
+		if got, want := rng.Float32(a), r.Float32(); got != want {
+			t.Errorf("Float32(), got %v, want %v", got, want)
+		}
+		if got, want := rng.Uint32(a), r.Uint32(); got != want {
+			t.Errorf("Uint32(), got %d, want %d", got, want)
+		}
+		if got, want := rng.Int31(a), r.Int31(); got != want {
+			t.Errorf("Int31(), got %d, want %d", got, want)
+		}
+		if got, want := rng.Int(a), r.Int(); got != want {
+			t.Errorf("Int(), got %d, want %d", got, want)
+		}
+		if got, want := rng.Int63(a), r.Int63(); got != want {
+			t.Errorf("Int63(), got %d, want %d", got, want)
+		}
+	}
+}
+
+func TestNormExpFloat64(t *testing.T) {
+	// Enough samples to exercise every layer and both tails.
+	a := rng.NewXoshiro256ss(1, 2, 3, 4)
+	r := rand.New(rng.NewXoshiro256ss(1, 2, 3, 4))
+	for i := 0; i < 1000000; i++ {
+		if got, want := rng.NormFloat64(a), r.NormFloat64(); got != want {
+			t.Fatalf("NormFloat64() #%d, got %v, want %v", i, got, want)
+		}
+		if got, want := rng.ExpFloat64(a), r.ExpFloat64(); got != want {
+			t.Fatalf("ExpFloat64() #%d, got %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestMethods(t *testing.T) {
+	// Every generator's methods must agree exactly with math/rand.Rand.
+	type methods interface {
+		Int31() int32
+		Int() int
+		Int63n(int64) int64
+		Int31n(int32) int32
+		Intn(int) int
+		Float64() float64
+		Float32() float32
+		NormFloat64() float64
+		ExpFloat64() float64
+	}
+	for _, name := range rng.Names() {
+		info := rng.Registry[name]
+		a, ok := info.New(1).(methods)
+		if !ok {
+			t.Errorf("%s does not implement the math/rand.Rand methods", name)
+			continue
+		}
+		r := rand.New(info.New(1))
+		for i := 0; i < 10000; i++ {
+			ns := []int{1, 3, 10, 1 << 20, 1<<31 - 1}
+			n := ns[i%len(ns)]
+			if got, want := a.Intn(n), r.Intn(n); got != want {
+				t.Fatalf("%s.Intn(%d), got %d, want %d", name, n, got, want)
+			}
+			if got, want := a.Int63n(1<<40+1), r.Int63n(1<<40+1); got != want {
+				t.Fatalf("%s.Int63n(), got %d, want %d", name, got, want)
+			}
+			if got, want := a.Int31n(7), r.Int31n(7); got != want {
+				t.Fatalf("%s.Int31n(7), got %d, want %d", name, got, want)
+			}
+			if got, want := a.Int31(), r.Int31(); got != want {
+				t.Fatalf("%s.Int31(), got %d, want %d", name, got, want)
+			}
+			if got, want := a.Int(), r.Int(); got != want {
+				t.Fatalf("%s.Int(), got %d, want %d", name, got, want)
+			}
+			if got, want := a.Float64(), r.Float64(); got != want {
+				t.Fatalf("%s.Float64(), got %v, want %v", name, got, want)
+			}
+			if got, want := a.Float32(), r.Float32(); got != want {
+				t.Fatalf("%s.Float32(), got %v, want %v", name, got, want)
+			}
+			if got, want := a.NormFloat64(), r.NormFloat64(); got != want {
+				t.Fatalf("%s.NormFloat64(), got %v, want %v", name, got, want)
+			}
+			if got, want := a.ExpFloat64(), r.ExpFloat64(); got != want {
+				t.Fatalf("%s.ExpFloat64(), got %v, want %v", name, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkFloat64(b *testing.B) {
+	r := rng.NewSplitMix64(uint64(b.N))
+	for i := 0; i < b.N; i++ {
+		rng.Float64(r)
+	}
+}
+
+func BenchmarkFloat64Method(b *testing.B) {
+	r := rng.NewSplitMix64(uint64(b.N))
+	for i := 0; i < b.N; i++ {
+		r.Float64()
+	}
+}
+
+func BenchmarkFloat64Interface(b *testing.B) {
+	r := rand.New(rng.NewSplitMix64(uint64(b.N)))
+	for i := 0; i < b.N; i++ {
+		r.Float64()
+	}
+}
+
+func BenchmarkIntn(b *testing.B) {
+	r := rng.NewSfc64(uint64(b.N), 0, 0)
+	for i := 0; i < b.N; i++ {
+		rng.Intn(r, 1000)
+	}
+}
+
+func BenchmarkIntnMethod(b *testing.B) {
+	r := rng.NewSfc64(uint64(b.N), 0, 0)
+	for i := 0; i < b.N; i++ {
+		r.Intn(1000)
+	}
+}
+
+func BenchmarkIntnInterface(b *testing.B) {
+	r := rand.New(rng.NewSfc64(uint64(b.N), 0, 0))
+	for i := 0; i < b.N; i++ {
+		r.Intn(1000)
+	}
+}
+
+func BenchmarkNormFloat64(b *testing.B) {
+	r := rng.NewSplitMix64(uint64(b.N))
+	for i := 0; i < b.N; i++ {
+		rng.NormFloat64(r)
+	}
+}
+
+func BenchmarkNormFloat64Method(b *testing.B) {
+	r := rng.NewSplitMix64(uint64(b.N))
+	for i := 0; i < b.N; i++ {
+		r.NormFloat64()
+	}
+}
+
+func BenchmarkNormFloat64Interface(b *testing.B) {
+	r := rand.New(rng.NewSplitMix64(uint64(b.N)))
+	for i := 0; i < b.N; i++ {
+		r.NormFloat64()
+	}
+}
@@ -0,0 +1,174 @@
+// This is free and unencumbered software released into the public domain.
+
+//go:build ignore
+// +build ignore
+
+// This program generates methods.go, the per-type math/rand.Rand
+// methods. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"log"
+	"os"
+	"text/template"
+)
+
+var generators = []struct{ Type, Recv string }{
+	{"Lcg128", "s"},
+	{"SplitMix64", "s"},
+	{"SplittableRandom", "s"},
+	{"Xoshiro256ss", "s"},
+	{"Pcg32", "s"},
+	{"Pcg32Stream", "s"},
+	{"Pcg64", "s"},
+	{"Pcg64Stream", "s"},
+	{"Pcg64Dxsm", "s"},
+	{"Pcg64x", "s"},
+	{"Msws64", "s"},
+	{"RomuDuo", "s"},
+	{"RomuDuoJr", "s"},
+	{"Mmlfg", "m"},
+	{"Mwc256xxa64", "m"},
+	{"Sfc64", "s"},
+}
+
+var methods = template.Must(template.New("methods").Parse(`
+// Code generated by genmethods.go; DO NOT EDIT.
+
+package rng
+
+import (
+	"math"
+)
+{{range .}}{{$t := .Type}}{{$r := .Recv}}
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func ({{$r}} *{{$t}}) Int31() int32 {
+	return int32({{$r}}.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func ({{$r}} *{{$t}}) Int() int {
+	u := uint({{$r}}.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func ({{$r}} *{{$t}}) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return {{$r}}.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := {{$r}}.Int63()
+	for v > max {
+		v = {{$r}}.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func ({{$r}} *{{$t}}) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return {{$r}}.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := {{$r}}.Int31()
+	for v > max {
+		v = {{$r}}.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func ({{$r}} *{{$t}}) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int({{$r}}.Int31n(int32(n)))
+	}
+	return int({{$r}}.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func ({{$r}} *{{$t}}) Float64() float64 {
+again:
+	f := float64({{$r}}.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func ({{$r}} *{{$t}}) Float32() float32 {
+again:
+	f := float32({{$r}}.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func ({{$r}} *{{$t}}) NormFloat64() float64 {
+	for {
+		j := int32(uint32({{$r}}.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail({{$r}}.Float64, j)
+		}
+		if randFn[i]+float32({{$r}}.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func ({{$r}} *{{$t}}) ExpFloat64() float64 {
+	for {
+		j := uint32({{$r}}.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log({{$r}}.Float64())
+		}
+		if randFe[i]+float32({{$r}}.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+{{end}}`[1:]))
+
+func main() {
+	var buf bytes.Buffer
+	if err := methods.Execute(&buf, generators); err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("methods.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,3 +1,3 @@
 module nullprogram.com/x/rng
 
-go 1.13
+go 1.18
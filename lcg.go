@@ -0,0 +1,266 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math/bits"
+)
+
+// Multipliers and increments for the linear congruential generators.
+const (
+	lcg128Mhi = 0x2d99787926d46932
+	lcg128Mlo = 0xa4c1f32680f70c55
+	pcg32M    = 0x5851f42d4c957f2d
+	pcg32A    = 0x14057b7ef767814f
+	pcg64Mhi  = 0x2360ed051fc65da4
+	pcg64Mlo  = 0x4385df649fccf645
+	pcg64Ahi  = 0x5851f42d4c957f2d
+	pcg64Alo  = 0x14057b7ef767814f
+	pcg64xM   = 0xb47d5ba190fb0fa5
+	msws64Khi = 0x918fba1eff8e67e1 // Weyl sequence increment
+	msws64Klo = 0x8367589d496e8afd
+
+	// Modular inverses of the multipliers, for stepping backwards.
+	lcg128Ihi = 0x860f93b69b6438f4
+	lcg128Ilo = 0x968378ee3a4590fd
+	pcg32I    = 0xc097ef87329e28a5
+	pcg64Ihi  = 0x07dda22b93979860
+	pcg64Ilo  = 0x98abc8b0716eac8d
+	pcg64xIhi = 0x9b804a2cc40d023f
+	pcg64xIlo = 0x0cd81535fe11402d
+)
+
+// u128 is an unsigned 128-bit integer for modular LCG arithmetic.
+type u128 struct{ hi, lo uint64 }
+
+func (a u128) add(b u128) u128 {
+	lo, c := bits.Add64(a.lo, b.lo, 0)
+	return u128{a.hi + b.hi + c, lo}
+}
+
+func (a u128) sub(b u128) u128 {
+	lo, c := bits.Sub64(a.lo, b.lo, 0)
+	return u128{a.hi - b.hi - c, lo}
+}
+
+func (a u128) neg() u128 {
+	return u128{}.sub(a)
+}
+
+func (a u128) mul(b u128) u128 {
+	hi, lo := bits.Mul64(a.lo, b.lo)
+	return u128{hi + a.hi*b.lo + a.lo*b.hi, lo}
+}
+
+// lcgAdvance64 computes the multiplier and increment equivalent to
+// delta steps of x = x*m + a (mod 2^64) by repeated squaring. See
+// Brown, "Random Number Generation with Arbitrary Stride" (1994).
+func lcgAdvance64(m, a, delta uint64) (uint64, uint64) {
+	accm, acca := uint64(1), uint64(0)
+	for ; delta > 0; delta >>= 1 {
+		if delta&1 != 0 {
+			accm *= m
+			acca = acca*m + a
+		}
+		a *= m + 1
+		m *= m
+	}
+	return accm, acca
+}
+
+// lcgAdvance128 is the 128-bit equivalent of lcgAdvance64.
+func lcgAdvance128(m, a, delta u128) (u128, u128) {
+	accm, acca := u128{0, 1}, u128{}
+	for delta.hi|delta.lo != 0 {
+		if delta.lo&1 != 0 {
+			accm = accm.mul(m)
+			acca = acca.mul(m).add(a)
+		}
+		a = a.mul(m.add(u128{0, 1}))
+		m = m.mul(m)
+		delta = u128{delta.hi >> 1, delta.lo>>1 | delta.hi<<63}
+	}
+	return accm, acca
+}
+
+// lcgDistance64 returns the number of steps of x = x*m + a (mod 2^64)
+// from state x to state y. The increment must be odd so that the
+// generator has full period. It determines the distance one bit at a
+// time, from the lowest bit, since the lowest k bits of the state have
+// period 2^k.
+func lcgDistance64(x, y, m, a uint64) uint64 {
+	var d uint64
+	for bit := uint64(1); x != y; bit <<= 1 {
+		if x&bit != y&bit {
+			x = x*m + a
+			d |= bit
+		}
+		a *= m + 1
+		m *= m
+	}
+	return d
+}
+
+// lcgDistance128 is the 128-bit equivalent of lcgDistance64.
+func lcgDistance128(x, y, m, a u128) u128 {
+	var d u128
+	for bit := (u128{0, 1}); x != y; {
+		if x.hi&bit.hi != y.hi&bit.hi || x.lo&bit.lo != y.lo&bit.lo {
+			x = x.mul(m).add(a)
+			d = u128{d.hi | bit.hi, d.lo | bit.lo}
+		}
+		a = a.mul(m.add(u128{0, 1}))
+		m = m.mul(m)
+		bit = u128{bit.hi<<1 | bit.lo>>63, bit.lo << 1}
+	}
+	return d
+}
+
+// Advance moves the generator forward by the 128-bit delta hi:lo, as
+// though Uint64() were called that many times, in O(log n) time.
+func (s *Lcg128) Advance(hi, lo uint64) {
+	m := u128{lcg128Mhi, lcg128Mlo}
+	m, a := lcgAdvance128(m, m, u128{hi, lo})
+	x := m.mul(u128{s.Hi, s.Lo}).add(a)
+	s.Hi, s.Lo = x.hi, x.lo
+}
+
+// Distance returns the 128-bit number of Uint64() calls needed to
+// move from state s to state t.
+func (s *Lcg128) Distance(t Lcg128) (hi, lo uint64) {
+	m := u128{lcg128Mhi, lcg128Mlo}
+	d := lcgDistance128(u128{s.Hi, s.Lo}, u128{t.Hi, t.Lo}, m, m)
+	return d.hi, d.lo
+}
+
+// Advance moves the generator forward by delta steps, as though
+// Uint32() were called that many times, in O(log n) time. Each call to
+// Uint64() is two steps.
+func (s *Pcg32) Advance(delta uint64) {
+	m, a := lcgAdvance64(pcg32M, pcg32A, delta)
+	*s = Pcg32(uint64(*s)*m + a)
+}
+
+// Distance returns the number of Uint32() calls needed to move from
+// state s to state t.
+func (s *Pcg32) Distance(t Pcg32) uint64 {
+	return lcgDistance64(uint64(*s), uint64(t), pcg32M, pcg32A)
+}
+
+// Advance moves the generator forward by the 128-bit delta hi:lo, as
+// though Uint64() were called that many times, in O(log n) time.
+func (s *Pcg64) Advance(hi, lo uint64) {
+	m := u128{pcg64Mhi, pcg64Mlo}
+	a := u128{pcg64Ahi, pcg64Alo}
+	m, a = lcgAdvance128(m, a, u128{hi, lo})
+	x := m.mul(u128{s.Hi, s.Lo}).add(a)
+	s.Hi, s.Lo = x.hi, x.lo
+}
+
+// Distance returns the 128-bit number of Uint64() calls needed to
+// move from state s to state t.
+func (s *Pcg64) Distance(t Pcg64) (hi, lo uint64) {
+	m := u128{pcg64Mhi, pcg64Mlo}
+	a := u128{pcg64Ahi, pcg64Alo}
+	d := lcgDistance128(u128{s.Hi, s.Lo}, u128{t.Hi, t.Lo}, m, a)
+	return d.hi, d.lo
+}
+
+// Advance moves the generator forward by the 128-bit delta hi:lo, as
+// though Uint64() were called that many times, in O(log n) time.
+func (s *Pcg64x) Advance(hi, lo uint64) {
+	m, a := lcgAdvance128(u128{0, pcg64xM}, u128{0, 1}, u128{hi, lo})
+	x := m.mul(u128{s.Hi, s.Lo}).add(a)
+	s.Hi, s.Lo = x.hi, x.lo
+}
+
+// Distance returns the 128-bit number of Uint64() calls needed to
+// move from state s to state t.
+func (s *Pcg64x) Distance(t Pcg64x) (hi, lo uint64) {
+	m, a := u128{0, pcg64xM}, u128{0, 1}
+	d := lcgDistance128(u128{s.Hi, s.Lo}, u128{t.Hi, t.Lo}, m, a)
+	return d.hi, d.lo
+}
+
+// Prev steps the generator backwards and returns the output of the
+// most recent call to Uint64(), undoing that call.
+func (s *Lcg128) Prev() uint64 {
+	r := s.Hi
+	m := u128{lcg128Mhi, lcg128Mlo}
+	x := u128{s.Hi, s.Lo}.sub(m).mul(u128{lcg128Ihi, lcg128Ilo})
+	s.Hi, s.Lo = x.hi, x.lo
+	return r
+}
+
+// Retreat moves the generator backward by the 128-bit delta hi:lo,
+// undoing that many calls to Uint64().
+func (s *Lcg128) Retreat(hi, lo uint64) {
+	d := u128{hi, lo}.neg()
+	s.Advance(d.hi, d.lo)
+}
+
+// PrevUint32 steps the generator backwards and returns the output of
+// the most recent call to Uint32(), undoing that call.
+func (s *Pcg32) PrevUint32() uint32 {
+	*s = Pcg32((uint64(*s) - pcg32A) * pcg32I)
+	r := *s
+	return r.Uint32()
+}
+
+// Prev steps the generator backwards and returns the output of the
+// most recent call to Uint64(), undoing that call.
+func (s *Pcg32) Prev() uint64 {
+	hi := uint64(s.PrevUint32())
+	lo := uint64(s.PrevUint32())
+	return hi<<32 | lo
+}
+
+// Retreat moves the generator backward by delta steps, undoing that
+// many calls to Uint32().
+func (s *Pcg32) Retreat(delta uint64) {
+	s.Advance(-delta)
+}
+
+// Prev steps the generator backwards and returns the output of the
+// most recent call to Uint64(), undoing that call.
+func (s *Pcg64) Prev() uint64 {
+	r := pcg64Output(s.Hi, s.Lo)
+	a := u128{pcg64Ahi, pcg64Alo}
+	x := u128{s.Hi, s.Lo}.sub(a).mul(u128{pcg64Ihi, pcg64Ilo})
+	s.Hi, s.Lo = x.hi, x.lo
+	return r
+}
+
+// Retreat moves the generator backward by the 128-bit delta hi:lo,
+// undoing that many calls to Uint64().
+func (s *Pcg64) Retreat(hi, lo uint64) {
+	d := u128{hi, lo}.neg()
+	s.Advance(d.hi, d.lo)
+}
+
+// Prev steps the generator backwards and returns the output of the
+// most recent call to Uint64(), undoing that call.
+func (s *Pcg64x) Prev() uint64 {
+	r := pcg64xOutput(s.Hi)
+	x := u128{s.Hi, s.Lo}.sub(u128{0, 1}).mul(u128{pcg64xIhi, pcg64xIlo})
+	s.Hi, s.Lo = x.hi, x.lo
+	return r
+}
+
+// Retreat moves the generator backward by the 128-bit delta hi:lo,
+// undoing that many calls to Uint64().
+func (s *Pcg64x) Retreat(hi, lo uint64) {
+	d := u128{hi, lo}.neg()
+	s.Advance(d.hi, d.lo)
+}
+
+// RetreatWeyl moves only the Weyl sequence of the generator backward
+// by the 128-bit delta hi:lo. The middle-square half of the state is
+// not invertible, since squaring discards information, so earlier
+// outputs cannot be recovered. This is useful for recovering the
+// position of a generator within its Weyl sequence.
+func (s *Msws64) RetreatWeyl(hi, lo uint64) {
+	k := u128{msws64Khi, msws64Klo}
+	w := u128{s[3], s[2]}.sub(k.mul(u128{hi, lo}))
+	s[2], s[3] = w.lo, w.hi
+}
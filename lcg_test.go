@@ -0,0 +1,159 @@
+package rng_test
+
+import (
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestLcg128Advance(t *testing.T) {
+	for _, n := range []uint64{0, 1, 2, 3, 100, 1000} {
+		start := rng.Lcg128{Hi: 1, Lo: 2}
+		a, b := start, start
+		for i := uint64(0); i < n; i++ {
+			a.Uint64()
+		}
+		b.Advance(0, n)
+		if a != b {
+			t.Errorf("Lcg128.Advance(%d), got %v, want %v", n, b, a)
+		}
+		if hi, lo := start.Distance(b); hi != 0 || lo != n {
+			t.Errorf("Lcg128.Distance(), got %#x:%#x, want %#x",
+				hi, lo, n)
+		}
+	}
+
+	// Advancing by 2^128 - 1 and then once more is a full period.
+	a := rng.Lcg128{Hi: 3, Lo: 4}
+	b := a
+	b.Advance(1<<64-1, 1<<64-1)
+	b.Uint64()
+	if a != b {
+		t.Errorf("Lcg128.Advance(2^128-1), got %v, want %v", b, a)
+	}
+}
+
+func TestPcg32Advance(t *testing.T) {
+	for _, n := range []uint64{0, 1, 2, 3, 100, 1000} {
+		a := rng.Pcg32(0)
+		b := a
+		for i := uint64(0); i < n; i++ {
+			a.Uint32()
+		}
+		b.Advance(n)
+		if a != b {
+			t.Errorf("Pcg32.Advance(%d), got %#x, want %#x", n, b, a)
+		}
+		if d := new(rng.Pcg32).Distance(b); d != n {
+			t.Errorf("Pcg32.Distance(), got %d, want %d", d, n)
+		}
+	}
+
+	a := rng.Pcg32(12345)
+	b := a
+	b.Advance(1<<64 - 1)
+	b.Uint32()
+	if a != b {
+		t.Errorf("Pcg32.Advance(2^64-1), got %#x, want %#x", b, a)
+	}
+	if d := a.Distance(rng.Pcg32(0)); d == 0 {
+		t.Errorf("Pcg32.Distance(), got 0 for distinct states")
+	}
+}
+
+func TestPcg64Advance(t *testing.T) {
+	for _, n := range []uint64{0, 1, 2, 3, 100, 1000} {
+		var a, b rng.Pcg64
+		for i := uint64(0); i < n; i++ {
+			a.Uint64()
+		}
+		b.Advance(0, n)
+		if a != b {
+			t.Errorf("Pcg64.Advance(%d), got %v, want %v", n, b, a)
+		}
+		if hi, lo := new(rng.Pcg64).Distance(b); hi != 0 || lo != n {
+			t.Errorf("Pcg64.Distance(), got %#x:%#x, want %#x",
+				hi, lo, n)
+		}
+	}
+
+	// A large distance must round trip through Distance.
+	var a, b rng.Pcg64
+	b.Advance(0x0123456789abcdef, 0xfedcba9876543210)
+	hi, lo := a.Distance(b)
+	if hi != 0x0123456789abcdef || lo != 0xfedcba9876543210 {
+		t.Errorf("Pcg64.Distance(), got %#x:%#x", hi, lo)
+	}
+}
+
+func TestPcg64xAdvance(t *testing.T) {
+	for _, n := range []uint64{0, 1, 2, 3, 100, 1000} {
+		var a rng.Pcg64x
+		a.Seed(0)
+		b := a
+		for i := uint64(0); i < n; i++ {
+			a.Uint64()
+		}
+		b.Advance(0, n)
+		if a != b {
+			t.Errorf("Pcg64x.Advance(%d), got %v, want %v", n, b, a)
+		}
+	}
+
+	var a rng.Pcg64x
+	a.Seed(0)
+	b := a
+	b.Advance(1<<63, 1)
+	hi, lo := a.Distance(b)
+	if hi != 1<<63 || lo != 1 {
+		t.Errorf("Pcg64x.Distance(), got %#x:%#x", hi, lo)
+	}
+}
+
+func TestRetreat(t *testing.T) {
+	lcg128 := rng.Lcg128{Hi: 1, Lo: 2}
+	pcg32 := rng.Pcg32(3)
+	pcg64 := rng.Pcg64{Hi: 4, Lo: 5}
+	pcg64x := rng.Pcg64x{Hi: 6, Lo: 7}
+	msws64 := rng.Msws64{8, 9, 10, 11}
+	a, b, c, d, e := lcg128, pcg32, pcg64, pcg64x, msws64
+	for i := 0; i < 1000; i++ {
+		a.Uint64()
+		b.Uint64()
+		c.Uint64()
+		d.Uint64()
+		e.Uint64()
+	}
+	a.Retreat(0, 1000)
+	b.Retreat(2000)
+	c.Retreat(0, 1000)
+	d.Retreat(0, 1000)
+	e.RetreatWeyl(0, 1000)
+	if a != lcg128 {
+		t.Errorf("Lcg128.Retreat(), got %v, want %v", a, lcg128)
+	}
+	if b != pcg32 {
+		t.Errorf("Pcg32.Retreat(), got %#x, want %#x", b, pcg32)
+	}
+	if c != pcg64 {
+		t.Errorf("Pcg64.Retreat(), got %v, want %v", c, pcg64)
+	}
+	if d != pcg64x {
+		t.Errorf("Pcg64x.Retreat(), got %v, want %v", d, pcg64x)
+	}
+	if e[2] != msws64[2] || e[3] != msws64[3] {
+		t.Errorf("Msws64.RetreatWeyl(), got %v, want %v", e, msws64)
+	}
+}
+
+func TestPcg32Prev(t *testing.T) {
+	var r rng.Pcg32
+	r.Seed(1)
+	want := []uint64{r.Uint64(), r.Uint64(), r.Uint64()}
+	for i := len(want) - 1; i >= 0; i-- {
+		if got := r.Prev(); got != want[i] {
+			t.Errorf("Pcg32.Prev(%d), got %#016x, want %#016x",
+				i, got, want[i])
+		}
+	}
+}
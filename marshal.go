@@ -0,0 +1,337 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// The binary state format is a version byte, a length-prefixed
+// generator name, then the state as little-endian 64-bit words:
+//
+//	version | len(name) | name | word0 | word1 | ...
+const marshalVersion = 1
+
+var (
+	errVersion      = errors.New("rng: unsupported state version")
+	errLength       = errors.New("rng: state has the wrong length")
+	errInvalidState = errors.New("rng: invalid generator state")
+)
+
+func marshalState(name string, w ...uint64) []byte {
+	b := make([]byte, 2+len(name)+8*len(w))
+	b[0] = marshalVersion
+	b[1] = byte(len(name))
+	copy(b[2:], name)
+	for i, v := range w {
+		binary.LittleEndian.PutUint64(b[2+len(name)+8*i:], v)
+	}
+	return b
+}
+
+func unmarshalState(name string, b []byte, n int) ([]uint64, error) {
+	w, err := unmarshalWords(name, b)
+	if err == nil && len(w) != n {
+		return nil, errLength
+	}
+	return w, err
+}
+
+// unmarshalWords decodes a state of any number of words.
+func unmarshalWords(name string, b []byte) ([]uint64, error) {
+	if len(b) < 2 || len(b) < 2+int(b[1]) {
+		return nil, errLength
+	}
+	if b[0] != marshalVersion {
+		return nil, errVersion
+	}
+	if got := string(b[2 : 2+int(b[1])]); got != name {
+		return nil, fmt.Errorf("rng: state is for %q, not %q", got, name)
+	}
+	b = b[2+len(name):]
+	if len(b)%8 != 0 {
+		return nil, errLength
+	}
+	w := make([]uint64, len(b)/8)
+	for i := range w {
+		w[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+	return w, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Lcg128) MarshalBinary() ([]byte, error) {
+	return marshalState("lcg128", s.Hi, s.Lo), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Lcg128) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("lcg128", b, 2)
+	if err != nil {
+		return err
+	}
+	s.Hi, s.Lo = w[0], w[1]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s SplitMix64) MarshalBinary() ([]byte, error) {
+	return marshalState("splitmix64", uint64(s)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *SplitMix64) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("splitmix64", b, 1)
+	if err != nil {
+		return err
+	}
+	*s = SplitMix64(w[0])
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s SplittableRandom) MarshalBinary() ([]byte, error) {
+	return marshalState("splittablerandom", s.State, s.Gamma), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The gamma
+// must be odd.
+func (s *SplittableRandom) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("splittablerandom", b, 2)
+	if err != nil {
+		return err
+	}
+	if w[1]&1 == 0 {
+		return errInvalidState
+	}
+	s.State, s.Gamma = w[0], w[1]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Xoshiro256ss) MarshalBinary() ([]byte, error) {
+	return marshalState("xoshiro256ss", s[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The state
+// must not be all zeros.
+func (s *Xoshiro256ss) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("xoshiro256ss", b, 4)
+	if err != nil {
+		return err
+	}
+	if w[0]|w[1]|w[2]|w[3] == 0 {
+		return errInvalidState
+	}
+	copy(s[:], w)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Pcg32) MarshalBinary() ([]byte, error) {
+	return marshalState("pcg32", uint64(s)), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Pcg32) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("pcg32", b, 1)
+	if err != nil {
+		return err
+	}
+	*s = Pcg32(w[0])
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Pcg32Stream) MarshalBinary() ([]byte, error) {
+	return marshalState("pcg32stream", s.State, s.Inc), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Pcg32Stream) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("pcg32stream", b, 2)
+	if err != nil {
+		return err
+	}
+	s.State, s.Inc = w[0], w[1]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Pcg64) MarshalBinary() ([]byte, error) {
+	return marshalState("pcg64", s.Hi, s.Lo), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Pcg64) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("pcg64", b, 2)
+	if err != nil {
+		return err
+	}
+	s.Hi, s.Lo = w[0], w[1]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Pcg64Stream) MarshalBinary() ([]byte, error) {
+	return marshalState("pcg64stream", s.Hi, s.Lo, s.IncHi, s.IncLo), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Pcg64Stream) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("pcg64stream", b, 4)
+	if err != nil {
+		return err
+	}
+	s.Hi, s.Lo, s.IncHi, s.IncLo = w[0], w[1], w[2], w[3]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Pcg64Dxsm) MarshalBinary() ([]byte, error) {
+	return marshalState("pcg64dxsm", s.Hi, s.Lo, s.IncHi, s.IncLo), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Pcg64Dxsm) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("pcg64dxsm", b, 4)
+	if err != nil {
+		return err
+	}
+	s.Hi, s.Lo, s.IncHi, s.IncLo = w[0], w[1], w[2], w[3]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Pcg64x) MarshalBinary() ([]byte, error) {
+	return marshalState("pcg64x", s.Hi, s.Lo), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Pcg64x) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("pcg64x", b, 2)
+	if err != nil {
+		return err
+	}
+	s.Hi, s.Lo = w[0], w[1]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Msws64) MarshalBinary() ([]byte, error) {
+	return marshalState("msws64", s[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Msws64) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("msws64", b, 4)
+	if err != nil {
+		return err
+	}
+	copy(s[:], w)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s RomuDuo) MarshalBinary() ([]byte, error) {
+	return marshalState("romuduo", s.x, s.y), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The state
+// must not be all zeros.
+func (s *RomuDuo) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("romuduo", b, 2)
+	if err != nil {
+		return err
+	}
+	if w[0]|w[1] == 0 {
+		return errInvalidState
+	}
+	s.x, s.y = w[0], w[1]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s RomuDuoJr) MarshalBinary() ([]byte, error) {
+	return marshalState("romuduojr", s.x, s.y), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The state
+// must not be all zeros.
+func (s *RomuDuoJr) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("romuduojr", b, 2)
+	if err != nil {
+		return err
+	}
+	if w[0]|w[1] == 0 {
+		return errInvalidState
+	}
+	s.x, s.y = w[0], w[1]
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The indices
+// follow the 15 state elements as two more words.
+func (m Mmlfg) MarshalBinary() ([]byte, error) {
+	w := append(m.s[:], uint64(m.i), uint64(m.j))
+	return marshalState("mmlfg", w...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. Every state
+// element must be odd, and the indices must be in range and two apart.
+func (m *Mmlfg) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("mmlfg", b, 17)
+	if err != nil {
+		return err
+	}
+	for _, v := range w[:15] {
+		if v&1 == 0 {
+			return errInvalidState
+		}
+	}
+	i, j := w[15], w[16]
+	if i > 14 || j > 14 || (i+13)%15 != j {
+		return errInvalidState
+	}
+	copy(m.s[:], w)
+	m.i, m.j = int32(i), int32(j)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (m Mwc256xxa64) MarshalBinary() ([]byte, error) {
+	return marshalState("mwc256xxa64", m[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. The carry
+// must be less than the multiplier, and the state must not be one of
+// the generator's two fixed points, the same states SeedUint64s
+// avoids.
+func (m *Mwc256xxa64) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("mwc256xxa64", b, 4)
+	if err != nil {
+		return err
+	}
+	if !mwc256xxa64Valid(w) {
+		return errInvalidState
+	}
+	copy(m[:], w)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s Sfc64) MarshalBinary() ([]byte, error) {
+	return marshalState("sfc64", s[:]...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Sfc64) UnmarshalBinary(b []byte) error {
+	w, err := unmarshalState("sfc64", b, 4)
+	if err != nil {
+		return err
+	}
+	copy(s[:], w)
+	return nil
+}
@@ -0,0 +1,144 @@
+package rng_test
+
+import (
+	"encoding"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+type marshaler interface {
+	rand.Source64
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+// generators returns, for every generator in the Registry, a function
+// that makes a fresh instance with the same seed.
+func generators() map[string]func() rand.Source64 {
+	gens := make(map[string]func() rand.Source64, len(rng.Registry))
+	for _, name := range rng.Names() {
+		info := rng.Registry[name]
+		gens[name] = func() rand.Source64 { return info.New(1) }
+	}
+	return gens
+}
+
+// marshalers is generators narrowed to marshaler, reporting any
+// generator that does not implement it.
+func marshalers(t *testing.T) map[string]func() marshaler {
+	t.Helper()
+	ms := make(map[string]func() marshaler)
+	for name, gen := range generators() {
+		gen := gen
+		if _, ok := gen().(marshaler); !ok {
+			t.Errorf("%s does not implement marshaler", name)
+			continue
+		}
+		ms[name] = func() marshaler { return gen().(marshaler) }
+	}
+	return ms
+}
+
+func TestMarshalBinary(t *testing.T) {
+	gens := marshalers(t)
+	for name, gen := range gens {
+		// Checkpoint mid-stream, then resume into a fresh instance.
+		a := gen()
+		for i := 0; i < 20; i++ {
+			a.Uint64()
+		}
+		buf, err := a.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s.MarshalBinary(), %v", name, err)
+		}
+		b := gen()
+		if err := b.UnmarshalBinary(buf); err != nil {
+			t.Fatalf("%s.UnmarshalBinary(), %v", name, err)
+		}
+		for i := 0; i < 20; i++ {
+			got, want := b.Uint64(), a.Uint64()
+			if got != want {
+				t.Errorf("%s resumed Uint64(%d), got %#016x, want %#016x",
+					name, i, got, want)
+			}
+		}
+
+		// Truncated state and states for other generators fail.
+		if err := b.UnmarshalBinary(buf[:len(buf)-1]); err == nil {
+			t.Errorf("%s.UnmarshalBinary() accepted truncated state", name)
+		}
+		for other, ogen := range gens {
+			if other == name {
+				continue
+			}
+			obuf, _ := ogen().MarshalBinary()
+			if err := b.UnmarshalBinary(obuf); err == nil {
+				t.Errorf("%s.UnmarshalBinary() accepted %s state",
+					name, other)
+			}
+		}
+	}
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	// A generator rejects its zero state exactly when that state is
+	// degenerate, producing a constant stream.
+	for name, gen := range marshalers(t) {
+		zero := reflect.New(reflect.TypeOf(gen()).Elem()).Interface().(marshaler)
+		buf, _ := zero.MarshalBinary()
+		first := zero.Uint64()
+		degenerate := true
+		for i := 0; i < 16; i++ {
+			if zero.Uint64() != first {
+				degenerate = false
+			}
+		}
+		err := gen().UnmarshalBinary(buf)
+		switch {
+		case degenerate && err == nil:
+			t.Errorf("%s.UnmarshalBinary() accepted the zero state", name)
+		case !degenerate && err != nil:
+			t.Errorf("%s.UnmarshalBinary() rejected the zero state, %v",
+				name, err)
+		}
+	}
+
+	// Mmlfg indices must be in range.
+	m := rng.NewMmlfg([15]uint64{})
+	buf, _ := m.MarshalBinary()
+	buf[len(buf)-16] = 15
+	if err := m.UnmarshalBinary(buf); err == nil {
+		t.Errorf("Mmlfg.UnmarshalBinary() accepted out of range index")
+	}
+
+	// Mwc256xxa64 rejects out of range carries and the all-ones fixed
+	// point, but a zero carry is reachable and accepted.
+	const a = 0xfeb344657c0af413
+	const max = 1<<64 - 1
+	for _, c := range []struct {
+		state rng.Mwc256xxa64
+		ok    bool
+	}{
+		{rng.Mwc256xxa64{1, 2, 3, a}, false},
+		{rng.Mwc256xxa64{max, max, max, a - 1}, false},
+		{rng.Mwc256xxa64{max, max, max, a - 2}, true},
+		{rng.Mwc256xxa64{1, 2, 3, 0}, true},
+	} {
+		buf, _ := c.state.MarshalBinary()
+		err := new(rng.Mwc256xxa64).UnmarshalBinary(buf)
+		if (err == nil) != c.ok {
+			t.Errorf("Mwc256xxa64.UnmarshalBinary(%#x), got %v", c.state, err)
+		}
+	}
+
+	// Unknown versions are rejected.
+	x := rng.NewXoshiro256ss(1, 2, 3, 4)
+	buf, _ = x.MarshalBinary()
+	buf[0] = 0xff
+	if err := x.UnmarshalBinary(buf); err == nil {
+		t.Errorf("Xoshiro256ss.UnmarshalBinary() accepted version 0xff")
+	}
+}
@@ -0,0 +1,1847 @@
+// Code generated by genmethods.go; DO NOT EDIT.
+
+package rng
+
+import (
+	"math"
+)
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Lcg128) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Lcg128) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Lcg128) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Lcg128) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Lcg128) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Lcg128) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Lcg128) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Lcg128) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Lcg128) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *SplitMix64) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *SplitMix64) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *SplitMix64) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *SplitMix64) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *SplitMix64) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *SplitMix64) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *SplitMix64) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *SplitMix64) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *SplitMix64) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *SplittableRandom) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *SplittableRandom) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *SplittableRandom) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *SplittableRandom) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *SplittableRandom) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *SplittableRandom) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *SplittableRandom) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *SplittableRandom) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *SplittableRandom) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Xoshiro256ss) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Xoshiro256ss) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Xoshiro256ss) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Xoshiro256ss) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Xoshiro256ss) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Xoshiro256ss) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Xoshiro256ss) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Xoshiro256ss) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Xoshiro256ss) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Pcg32) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Pcg32) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg32) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg32) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg32) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg32) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg32) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Pcg32) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Pcg32) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Pcg32Stream) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Pcg32Stream) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg32Stream) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg32Stream) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg32Stream) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg32Stream) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg32Stream) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Pcg32Stream) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Pcg32Stream) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Pcg64) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Pcg64) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg64) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg64) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Pcg64) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Pcg64) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Pcg64Stream) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Pcg64Stream) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64Stream) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64Stream) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64Stream) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg64Stream) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg64Stream) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Pcg64Stream) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Pcg64Stream) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Pcg64Dxsm) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Pcg64Dxsm) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64Dxsm) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64Dxsm) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64Dxsm) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg64Dxsm) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg64Dxsm) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Pcg64Dxsm) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Pcg64Dxsm) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Pcg64x) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Pcg64x) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64x) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64x) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Pcg64x) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg64x) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Pcg64x) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Pcg64x) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Pcg64x) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Msws64) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Msws64) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Msws64) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Msws64) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Msws64) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Msws64) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Msws64) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Msws64) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Msws64) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *RomuDuo) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *RomuDuo) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *RomuDuo) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *RomuDuo) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *RomuDuo) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *RomuDuo) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *RomuDuo) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *RomuDuo) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *RomuDuo) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *RomuDuoJr) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *RomuDuoJr) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *RomuDuoJr) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *RomuDuoJr) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *RomuDuoJr) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *RomuDuoJr) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *RomuDuoJr) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *RomuDuoJr) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *RomuDuoJr) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (m *Mmlfg) Int31() int32 {
+	return int32(m.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (m *Mmlfg) Int() int {
+	u := uint(m.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (m *Mmlfg) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return m.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := m.Int63()
+	for v > max {
+		v = m.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (m *Mmlfg) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return m.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := m.Int31()
+	for v > max {
+		v = m.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (m *Mmlfg) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(m.Int31n(int32(n)))
+	}
+	return int(m.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (m *Mmlfg) Float64() float64 {
+again:
+	f := float64(m.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (m *Mmlfg) Float32() float32 {
+again:
+	f := float32(m.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (m *Mmlfg) NormFloat64() float64 {
+	for {
+		j := int32(uint32(m.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(m.Float64, j)
+		}
+		if randFn[i]+float32(m.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (m *Mmlfg) ExpFloat64() float64 {
+	for {
+		j := uint32(m.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(m.Float64())
+		}
+		if randFe[i]+float32(m.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (m *Mwc256xxa64) Int31() int32 {
+	return int32(m.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (m *Mwc256xxa64) Int() int {
+	u := uint(m.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (m *Mwc256xxa64) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return m.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := m.Int63()
+	for v > max {
+		v = m.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (m *Mwc256xxa64) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return m.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := m.Int31()
+	for v > max {
+		v = m.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (m *Mwc256xxa64) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(m.Int31n(int32(n)))
+	}
+	return int(m.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (m *Mwc256xxa64) Float64() float64 {
+again:
+	f := float64(m.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (m *Mwc256xxa64) Float32() float32 {
+again:
+	f := float32(m.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (m *Mwc256xxa64) NormFloat64() float64 {
+	for {
+		j := int32(uint32(m.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(m.Float64, j)
+		}
+		if randFn[i]+float32(m.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (m *Mwc256xxa64) ExpFloat64() float64 {
+	for {
+		j := uint32(m.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(m.Float64())
+		}
+		if randFe[i]+float32(m.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
+
+// Int31 returns a non-negative pseudo-random 31-bit integer.
+func (s *Sfc64) Int31() int32 {
+	return int32(s.Int63() >> 32)
+}
+
+// Int returns a non-negative pseudo-random int.
+func (s *Sfc64) Int() int {
+	u := uint(s.Int63())
+	return int(u << 1 >> 1)
+}
+
+// Int63n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Sfc64) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int63() & (n - 1)
+	}
+	max := int64((1 << 63) - 1 - (1<<63)%uint64(n))
+	v := s.Int63()
+	for v > max {
+		v = s.Int63()
+	}
+	return v % n
+}
+
+// Int31n returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Sfc64) Int31n(n int32) int32 {
+	if n <= 0 {
+		panic("invalid argument to Int31n")
+	}
+	if n&(n-1) == 0 {
+		return s.Int31() & (n - 1)
+	}
+	max := int32((1 << 31) - 1 - (1<<31)%uint32(n))
+	v := s.Int31()
+	for v > max {
+		v = s.Int31()
+	}
+	return v % n
+}
+
+// Intn returns a non-negative pseudo-random number in [0, n). It
+// panics if n <= 0.
+func (s *Sfc64) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	if n <= 1<<31-1 {
+		return int(s.Int31n(int32(n)))
+	}
+	return int(s.Int63n(int64(n)))
+}
+
+// Float64 returns a pseudo-random number in [0.0, 1.0).
+func (s *Sfc64) Float64() float64 {
+again:
+	f := float64(s.Int63()) / (1 << 63)
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// Float32 returns a pseudo-random number in [0.0, 1.0).
+func (s *Sfc64) Float32() float32 {
+again:
+	f := float32(s.Float64())
+	if f == 1 {
+		goto again
+	}
+	return f
+}
+
+// NormFloat64 returns a normally distributed float64 with standard
+// normal distribution (mean = 0, stddev = 1).
+func (s *Sfc64) NormFloat64() float64 {
+	for {
+		j := int32(uint32(s.Int63() >> 31)) // possibly negative
+		i := j & 0x7f
+		x := float64(j) * float64(randWn[i])
+		if absInt32(j) < randKn[i] {
+			return x
+		}
+		if i == 0 {
+			return normTail(s.Float64, j)
+		}
+		if randFn[i]+float32(s.Float64())*(randFn[i-1]-randFn[i]) < float32(math.Exp(-.5*x*x)) {
+			return x
+		}
+	}
+}
+
+// ExpFloat64 returns an exponentially distributed float64 with rate
+// parameter (lambda) 1 and mean 1/lambda (1).
+func (s *Sfc64) ExpFloat64() float64 {
+	for {
+		j := uint32(s.Int63() >> 31)
+		i := j & 0xff
+		x := float64(j) * float64(randWe[i])
+		if j < randKe[i] {
+			return x
+		}
+		if i == 0 {
+			return randRe - math.Log(s.Float64())
+		}
+		if randFe[i]+float32(s.Float64())*(randFe[i-1]-randFe[i]) < float32(math.Exp(-x)) {
+			return x
+		}
+	}
+}
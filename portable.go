@@ -0,0 +1,116 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math"
+)
+
+// The math package may compute Log and Exp with assembly that differs
+// by platform, and the compiler may fuse a multiply and add into one
+// FMA instruction on some architectures, each changing the last bit of
+// a result. The functions here are the math package's portable Go
+// versions, from FreeBSD's msun, with every product that feeds an
+// addition wrapped in an explicit float64 conversion, which the Go
+// specification says prevents fusion. Their results are therefore the
+// same everywhere, for samplers whose output must be reproducible
+// from a seed.
+
+// portableLog returns the natural logarithm of x.
+func portableLog(x float64) float64 {
+	const (
+		ln2Hi = 6.93147180369123816490e-01 // 3fe62e42 fee00000
+		ln2Lo = 1.90821492927058770002e-10 // 3dea39ef 35793c76
+		l1    = 6.666666666666735130e-01   // 3fe55555 55555593
+		l2    = 3.999999999940941908e-01   // 3fd99999 9997fa04
+		l3    = 2.857142874366239149e-01   // 3fd24924 94229359
+		l4    = 2.222219843214978396e-01   // 3fcc71c5 1d8e78af
+		l5    = 1.818357216161805012e-01   // 3fc74664 96cb03de
+		l6    = 1.531383769920937332e-01   // 3fc39a09 d078c69f
+		l7    = 1.479819860511658591e-01   // 3fc2f112 df3e5244
+	)
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 1):
+		return x
+	case x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	}
+
+	f1, ki := math.Frexp(x)
+	if f1 < math.Sqrt2/2 {
+		f1 *= 2
+		ki--
+	}
+	f := f1 - 1
+	k := float64(ki)
+
+	s := f / (2 + f)
+	s2 := s * s
+	s4 := s2 * s2
+	t1 := float64(s2 * (l1 + float64(s4*(l3+float64(s4*(l5+float64(s4*l7)))))))
+	t2 := float64(s4 * (l2 + float64(s4*(l4+float64(s4*l6)))))
+	r := t1 + t2
+	hfsq := float64(0.5 * f * f)
+	return float64(k*ln2Hi) - ((hfsq - (float64(s*(hfsq+r)) + float64(k*ln2Lo))) - f)
+}
+
+// portableLog1p returns the natural logarithm of 1 + x, accurate for
+// small x. It corrects the rounding of 1 + x as in Goldberg, "What
+// Every Computer Scientist Should Know About Floating-Point
+// Arithmetic" (1991), theorem 4.
+func portableLog1p(x float64) float64 {
+	u := 1 + x
+	if u == 1 {
+		return x
+	}
+	return float64(portableLog(u)*x) / (u - 1)
+}
+
+// portableExp returns e**x.
+func portableExp(x float64) float64 {
+	const (
+		ln2Hi     = 6.93147180369123816490e-01
+		ln2Lo     = 1.90821492927058770002e-10
+		log2e     = 1.44269504088896338700e+00
+		overflow  = 7.09782712893383973096e+02
+		underflow = -7.45133219101941108420e+02
+		nearZero  = 1.0 / (1 << 28)
+
+		p1 = 1.66666666666666657415e-01  // 3fc55555 55555555
+		p2 = -2.77777777770155933842e-03 // bf66c16c 16bebd93
+		p3 = 6.61375632143793436117e-05  // 3f11566a af25de2c
+		p4 = -1.65339022054652515390e-06 // bebbbd41 c5d26bf1
+		p5 = 4.13813679705723846039e-08  // 3e663769 72bea4d0
+	)
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 1):
+		return x
+	case math.IsInf(x, -1):
+		return 0
+	case x > overflow:
+		return math.Inf(1)
+	case x < underflow:
+		return 0
+	case -nearZero < x && x < nearZero:
+		return 1 + x
+	}
+
+	// Reduce to r = hi - lo in [-ln2/2, ln2/2], with x = k*ln2 + r.
+	var k int
+	switch {
+	case x < 0:
+		k = int(float64(log2e*x) - 0.5)
+	case x > 0:
+		k = int(float64(log2e*x) + 0.5)
+	}
+	hi := x - float64(float64(k)*ln2Hi)
+	lo := float64(float64(k) * ln2Lo)
+
+	r := hi - lo
+	t := r * r
+	c := r - float64(t*(p1+float64(t*(p2+float64(t*(p3+float64(t*(p4+float64(t*p5)))))))))
+	y := 1 - ((lo - float64(r*c)/(2-c)) - hi)
+	return math.Ldexp(y, k)
+}
@@ -0,0 +1,64 @@
+package rng_test
+
+import (
+	"math"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestPortableMath(t *testing.T) {
+	// Results are pinned bit for bit, since they must not vary by
+	// platform.
+	table := []struct {
+		name string
+		f    func(float64) float64
+		x    float64
+		want uint64
+	}{
+		{"Log", rng.PortableLog, 0.1, 0xc0026bb1bbb55515},
+		{"Log", rng.PortableLog, 0.5, 0xbfe62e42fefa39ef},
+		{"Log", rng.PortableLog, 0.9999, 0xbf1a3738d2cf1cc2},
+		{"Log", rng.PortableLog, 1e-300, 0xc085963447f87fb5},
+		{"Log", rng.PortableLog, 123.456, 0x401343774f3e2362},
+		{"Log1p", rng.PortableLog1p, -1e-12, 0xbd719799812df3bd},
+		{"Log1p", rng.PortableLog1p, -0.25, 0xbfd269621134db92},
+		{"Log1p", rng.PortableLog1p, -0.999, 0xc01ba18a998fff9f},
+		{"Exp", rng.PortableExp, -700, 0x00d14f2b0fb9307f},
+		{"Exp", rng.PortableExp, -1.5, 0x3fcc8f87724b5c1d},
+		{"Exp", rng.PortableExp, 0.3, 0x3ff599058c8c1a96},
+		{"Exp", rng.PortableExp, 1, 0x4005bf0a8b145769},
+		{"Exp", rng.PortableExp, 700, 0x7f0d945df4f8ec8e},
+	}
+	for _, e := range table {
+		if got := math.Float64bits(e.f(e.x)); got != e.want {
+			t.Errorf("%s(%v), got %#016x, want %#016x",
+				e.name, e.x, got, e.want)
+		}
+	}
+
+	// Elsewhere they agree closely with the math package, whose own
+	// results vary by platform.
+	ulps := func(a, b float64) uint64 {
+		d := int64(math.Float64bits(a) - math.Float64bits(b))
+		if d < 0 {
+			d = -d
+		}
+		return uint64(d)
+	}
+	r := rng.NewSfc64(1, 2, 3)
+	for i := 0; i < 100000; i++ {
+		u := rng.OpenFloat64(r)
+		x := math.Ldexp(u, int(rng.Uint64n(r, 200))-100)
+		if d := ulps(rng.PortableLog(x), math.Log(x)); d > 1 {
+			t.Fatalf("Log(%v), %d ulps from math.Log", x, d)
+		}
+		if d := ulps(rng.PortableLog1p(-u), math.Log1p(-u)); d > 2 {
+			t.Fatalf("Log1p(%v), %d ulps from math.Log1p", -u, d)
+		}
+		y := (2*u - 1) * 700
+		if d := ulps(rng.PortableExp(y), math.Exp(y)); d > 2 {
+			t.Fatalf("Exp(%v), %d ulps from math.Exp", y, d)
+		}
+	}
+}
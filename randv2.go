@@ -0,0 +1,31 @@
+// This is free and unencumbered software released into the public domain.
+
+//go:build go1.22
+// +build go1.22
+
+package rng
+
+import (
+	randv2 "math/rand/v2"
+)
+
+// Every generator also implements math/rand/v2.Source, so it may be
+// passed directly to math/rand/v2.New.
+var (
+	_ randv2.Source = (*Lcg128)(nil)
+	_ randv2.Source = (*SplitMix64)(nil)
+	_ randv2.Source = (*SplittableRandom)(nil)
+	_ randv2.Source = (*Xoshiro256ss)(nil)
+	_ randv2.Source = (*Pcg32)(nil)
+	_ randv2.Source = (*Pcg32Stream)(nil)
+	_ randv2.Source = (*Pcg64)(nil)
+	_ randv2.Source = (*Pcg64Stream)(nil)
+	_ randv2.Source = (*Pcg64Dxsm)(nil)
+	_ randv2.Source = (*Pcg64x)(nil)
+	_ randv2.Source = (*Msws64)(nil)
+	_ randv2.Source = (*RomuDuo)(nil)
+	_ randv2.Source = (*RomuDuoJr)(nil)
+	_ randv2.Source = (*Mmlfg)(nil)
+	_ randv2.Source = (*Mwc256xxa64)(nil)
+	_ randv2.Source = (*Sfc64)(nil)
+)
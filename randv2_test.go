@@ -0,0 +1,33 @@
+//go:build go1.22
+// +build go1.22
+
+package rng_test
+
+import (
+	randv2 "math/rand/v2"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestRandV2(t *testing.T) {
+	// Values drawn through math/rand/v2 must match direct calls.
+	a := rng.NewSfc64(1, 2, 3)
+	b := rng.NewSfc64(1, 2, 3)
+	r := randv2.New(a)
+	for i := 0; i < 16; i++ {
+		got := r.Uint64()
+		want := b.Uint64()
+		if got != want {
+			t.Errorf("rand/v2.Uint64(%d), got %#016x, want %#016x",
+				i, got, want)
+		}
+	}
+}
+
+func BenchmarkSfc64InterfaceV2(b *testing.B) {
+	r := randv2.New(rng.NewSfc64(uint64(b.N), 0, 0))
+	for i := 0; i < b.N; i++ {
+		r.Uint64()
+	}
+}
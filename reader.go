@@ -0,0 +1,50 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"encoding/binary"
+	"io"
+	"math/rand"
+)
+
+// A Reader is an io.Reader producing the output of a Source64 as a
+// byte stream. Each 64-bit output is written in little-endian byte
+// order, so the stream is the same on every architecture, and bytes
+// left over from one Read are returned by the next rather than
+// discarded. Read never fails.
+type Reader struct {
+	src  rand.Source64
+	last uint64 // unread bytes of the most recent output
+	n    int    // number of unread bytes in last
+}
+
+var _ io.Reader = (*Reader)(nil)
+
+// NewReader returns a Reader over the given source.
+func NewReader(src rand.Source64) *Reader {
+	return &Reader{src: src}
+}
+
+func (r *Reader) Read(p []byte) (int, error) {
+	total := len(p)
+	for ; r.n > 0 && len(p) > 0; r.n-- {
+		p[0] = byte(r.last)
+		r.last >>= 8
+		p = p[1:]
+	}
+	for len(p) >= 8 {
+		binary.LittleEndian.PutUint64(p, r.src.Uint64())
+		p = p[8:]
+	}
+	if len(p) > 0 {
+		r.last = r.src.Uint64()
+		r.n = 8
+		for ; len(p) > 0; r.n-- {
+			p[0] = byte(r.last)
+			r.last >>= 8
+			p = p[1:]
+		}
+	}
+	return total, nil
+}
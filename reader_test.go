@@ -0,0 +1,54 @@
+package rng_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestReader(t *testing.T) {
+	want := make([]byte, 8*64)
+	s := rng.NewSfc64(1, 2, 3)
+	for i := 0; i < len(want); i += 8 {
+		binary.LittleEndian.PutUint64(want[i:], s.Uint64())
+	}
+
+	// Reads of every size must yield the same byte stream.
+	for size := 1; size <= 17; size++ {
+		r := rng.NewReader(rng.NewSfc64(1, 2, 3))
+		got := make([]byte, 0, len(want))
+		buf := make([]byte, size)
+		for len(got) < len(want) {
+			if rem := len(want) - len(got); rem < len(buf) {
+				buf = buf[:rem]
+			}
+			n, err := r.Read(buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, buf[:n]...)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("Reader.Read() with %d-byte reads, got %x, want %x",
+				size, got[:16], want[:16])
+		}
+	}
+
+	var buf bytes.Buffer
+	io.CopyN(&buf, rng.NewReader(rng.NewSfc64(1, 2, 3)), int64(len(want)))
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("io.CopyN(Reader) produced a different stream")
+	}
+}
+
+func BenchmarkReader(b *testing.B) {
+	r := rng.NewReader(new(rng.Sfc64))
+	buf := make([]byte, 1<<12)
+	b.SetBytes(int64(len(buf)))
+	for i := 0; i < b.N; i++ {
+		r.Read(buf)
+	}
+}
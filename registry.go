@@ -0,0 +1,240 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// An Info describes a generator in the Registry.
+type Info struct {
+	Name string
+
+	// New returns a new instance seeded with Seed().
+	New func(seed int64) rand.Source64
+
+	// Reference returns the instance the quality results describe, and
+	// whose output cmd/rngdump writes: the zero state, or Seed(0) where
+	// the zero state is invalid.
+	Reference func() rand.Source64
+
+	StateSize  int    // state size in bytes
+	OutputBits int    // bits per native output
+	Period     string // e.g. "2^128", or "unknown" for chaotic generators
+	Jumpable   bool   // supports skipping ahead with Jump or Advance
+
+	// Statistical quality results, as in the README. Empty means the
+	// generator has not been tested.
+	Dieharder string
+	BigCrush  string
+	PractRand string
+}
+
+// Registry maps generator names to their descriptions. The names are
+// the same ones used by the binary and text state formats.
+var Registry = map[string]Info{
+	"lcg128": {
+		Name: "lcg128", New: func(seed int64) rand.Source64 {
+			s := new(Lcg128)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 { return new(Lcg128) },
+		StateSize: 16, OutputBits: 64, Period: "2^128", Jumpable: true,
+		Dieharder: "PASS", BigCrush: "1 fail", PractRand: "128GB",
+	},
+	"splitmix64": {
+		Name: "splitmix64", New: func(seed int64) rand.Source64 {
+			s := new(SplitMix64)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 { return new(SplitMix64) },
+		StateSize: 8, OutputBits: 64, Period: "2^64",
+		Dieharder: "PASS", BigCrush: "1 fail", PractRand: "> 8TB",
+	},
+	"splittablerandom": {
+		Name: "splittablerandom", New: func(seed int64) rand.Source64 {
+			s := new(SplittableRandom)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 {
+			s := new(SplittableRandom)
+			s.Seed(0)
+			return s
+		},
+		StateSize: 16, OutputBits: 64, Period: "2^64",
+	},
+	"xoshiro256ss": {
+		Name: "xoshiro256ss", New: func(seed int64) rand.Source64 {
+			s := new(Xoshiro256ss)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 {
+			s := new(Xoshiro256ss)
+			s.Seed(0)
+			return s
+		},
+		StateSize: 32, OutputBits: 64, Period: "2^256-1", Jumpable: true,
+		Dieharder: "PASS", BigCrush: "PASS", PractRand: "> 8TB",
+	},
+	"pcg32": {
+		Name: "pcg32", New: func(seed int64) rand.Source64 {
+			s := new(Pcg32)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 { return new(Pcg32) },
+		StateSize: 8, OutputBits: 32, Period: "2^64", Jumpable: true,
+		Dieharder: "PASS", BigCrush: "1 fail", PractRand: "> 8TB",
+	},
+	"pcg32stream": {
+		Name: "pcg32stream", New: func(seed int64) rand.Source64 {
+			s := new(Pcg32Stream)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 { return new(Pcg32Stream) },
+		StateSize: 16, OutputBits: 32, Period: "2^64",
+	},
+	"pcg64": {
+		Name: "pcg64", New: func(seed int64) rand.Source64 {
+			s := new(Pcg64)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 { return new(Pcg64) },
+		StateSize: 16, OutputBits: 64, Period: "2^128", Jumpable: true,
+		Dieharder: "PASS", BigCrush: "PASS", PractRand: "> 8TB",
+	},
+	"pcg64stream": {
+		Name: "pcg64stream", New: func(seed int64) rand.Source64 {
+			s := new(Pcg64Stream)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 { return new(Pcg64Stream) },
+		StateSize: 32, OutputBits: 64, Period: "2^128",
+	},
+	"pcg64dxsm": {
+		Name: "pcg64dxsm", New: func(seed int64) rand.Source64 {
+			s := new(Pcg64Dxsm)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 { return new(Pcg64Dxsm) },
+		StateSize: 32, OutputBits: 64, Period: "2^128",
+	},
+	"pcg64x": {
+		Name: "pcg64x", New: func(seed int64) rand.Source64 {
+			s := new(Pcg64x)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 { return new(Pcg64x) },
+		StateSize: 16, OutputBits: 64, Period: "2^128", Jumpable: true,
+		Dieharder: "PASS", BigCrush: "PASS", PractRand: "> 8TB",
+	},
+	"msws64": {
+		Name: "msws64", New: func(seed int64) rand.Source64 {
+			s := new(Msws64)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 { return new(Msws64) },
+		StateSize: 32, OutputBits: 64, Period: "2^128",
+		Dieharder: "PASS", BigCrush: "PASS", PractRand: "> 8TB",
+	},
+	"romuduo": {
+		Name: "romuduo", New: func(seed int64) rand.Source64 {
+			s := new(RomuDuo)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 {
+			s := new(RomuDuo)
+			s.Seed(0)
+			return s
+		},
+		StateSize: 16, OutputBits: 64, Period: "unknown",
+		Dieharder: "PASS", BigCrush: "PASS", PractRand: "> 8TB",
+	},
+	"romuduojr": {
+		Name: "romuduojr", New: func(seed int64) rand.Source64 {
+			s := new(RomuDuoJr)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 {
+			s := new(RomuDuoJr)
+			s.Seed(0)
+			return s
+		},
+		StateSize: 16, OutputBits: 64, Period: "unknown",
+		Dieharder: "PASS", BigCrush: "3 fail", PractRand: "> 8TB",
+	},
+	"mmlfg": {
+		Name: "mmlfg", New: func(seed int64) rand.Source64 {
+			s := new(Mmlfg)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 {
+			s := new(Mmlfg)
+			s.Seed(0)
+			return s
+		},
+		StateSize: 128, OutputBits: 64, Period: "unknown",
+		Dieharder: "PASS", BigCrush: "PASS", PractRand: "> 8TB",
+	},
+	"mwc256xxa64": {
+		Name: "mwc256xxa64", New: func(seed int64) rand.Source64 {
+			s := new(Mwc256xxa64)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 {
+			s := new(Mwc256xxa64)
+			s.Seed(0)
+			return s
+		},
+		StateSize: 32, OutputBits: 64, Period: "~2^255",
+	},
+	"sfc64": {
+		Name: "sfc64", New: func(seed int64) rand.Source64 {
+			s := new(Sfc64)
+			s.Seed(seed)
+			return s
+		},
+		Reference: func() rand.Source64 {
+			s := new(Sfc64)
+			s.Seed(0)
+			return s
+		},
+		StateSize: 32, OutputBits: 64, Period: ">= 2^64",
+		Dieharder: "PASS", BigCrush: "PASS", PractRand: "> 8TB",
+	},
+}
+
+// Names returns the names in the Registry in sorted order.
+func Names() []string {
+	names := make([]string, 0, len(Registry))
+	for name := range Registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New returns the named generator from the Registry seeded with seed.
+func New(name string, seed int64) (rand.Source64, error) {
+	info, ok := Registry[name]
+	if !ok {
+		return nil, fmt.Errorf("rng: unknown generator %q", name)
+	}
+	return info.New(seed), nil
+}
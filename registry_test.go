@@ -0,0 +1,100 @@
+package rng_test
+
+import (
+	"strings"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestRegistry(t *testing.T) {
+	names := rng.Names()
+	if len(names) != len(rng.Registry) {
+		t.Fatalf("Names(), got %d names, want %d",
+			len(names), len(rng.Registry))
+	}
+	for _, name := range names {
+		info := rng.Registry[name]
+		if info.Name != name {
+			t.Errorf("Registry[%q].Name, got %q", name, info.Name)
+		}
+		a, err := rng.New(name, 1)
+		if err != nil {
+			t.Fatalf("New(%q), %v", name, err)
+		}
+		b := info.New(1)
+		c := info.New(2)
+		same := 0
+		for i := 0; i < 64; i++ {
+			x := a.Uint64()
+			if y := b.Uint64(); x != y {
+				t.Fatalf("New(%q) Uint64(%d), got %#016x, want %#016x",
+					name, i, y, x)
+			}
+			if x == c.Uint64() {
+				same++
+			}
+		}
+		if same > 1 {
+			t.Errorf("New(%q) seeds 1 and 2 share %d outputs", name, same)
+		}
+
+		// Registry names match the state serialization tags.
+		if m, ok := a.(interface{ MarshalText() ([]byte, error) }); ok {
+			text, _ := m.MarshalText()
+			if !strings.HasPrefix(string(text), name+":") {
+				t.Errorf("Registry name %q, state text %q", name, text)
+			}
+		} else {
+			t.Errorf("%q does not implement MarshalText", name)
+		}
+	}
+
+	if _, err := rng.New("nonexistent", 0); err == nil {
+		t.Errorf("New(\"nonexistent\") succeeded")
+	}
+}
+
+func TestRegistryReference(t *testing.T) {
+	// The quality results were measured on these streams, as dumped by
+	// cmd/rngdump, so they must not change. Each is pinned by its 64th
+	// output.
+	want := map[string]uint64{
+		"lcg128":           0x7cd0d926ce9acd6e,
+		"mmlfg":            0xcaf2603b716463e7,
+		"msws64":           0x73922a240aebc550,
+		"mwc256xxa64":      0x2ad236be193e74fe,
+		"pcg32":            0xde803845ea0d1530,
+		"pcg32stream":      0x52e250b10e8db22d,
+		"pcg64":            0x47a18b0759162e1e,
+		"pcg64dxsm":        0x2ad70b03b563a0e1,
+		"pcg64stream":      0x3f0bb2cd7cedd012,
+		"pcg64x":           0x6b20e420c6669564,
+		"romuduo":          0xd2766d2465f3bcab,
+		"romuduojr":        0xac1a089fa3606430,
+		"sfc64":            0x2c2900ef1f98b849,
+		"splitmix64":       0xe255b237b8bb18fb,
+		"splittablerandom": 0xe255b237b8bb18fb,
+		"xoshiro256ss":     0x099c2939ea690a80,
+	}
+	for _, name := range rng.Names() {
+		info := rng.Registry[name]
+		if info.Reference == nil {
+			t.Errorf("Registry[%q] has no Reference", name)
+			continue
+		}
+		w, ok := want[name]
+		if !ok {
+			t.Errorf("Registry[%q] reference stream is not pinned", name)
+			continue
+		}
+		r := info.Reference()
+		for i := 0; i < 63; i++ {
+			r.Uint64()
+		}
+		if got := r.Uint64(); got != w {
+			t.Errorf("Registry[%q].Reference() Uint64(63), got %#016x, want %#016x",
+				name, got, w)
+		}
+	}
+}
@@ -2,7 +2,7 @@
 
 // Package rng provides several more efficient PRNGs sources for use
 // with math/rand.Rand. Each PRNG implements the math/rand.Source64
-// interface.
+// interface, and also the math/rand/v2.Source interface.
 package rng
 
 import (
@@ -22,10 +22,15 @@ func (s *Lcg128) Seed(seed int64) {
 	s.Hi = 0
 }
 
+// NewLcg128 returns an Lcg128 with the given 128-bit state.
+func NewLcg128(hi, lo uint64) *Lcg128 {
+	return &Lcg128{hi, lo}
+}
+
 func (s *Lcg128) Uint64() uint64 {
 	const (
-		mhi = 0x2d99787926d46932
-		mlo = 0xa4c1f32680f70c55
+		mhi = lcg128Mhi
+		mlo = lcg128Mlo
 	)
 	carry, lo := bits.Mul64(mlo, s.Lo)
 	hi := mhi*s.Lo + s.Hi*mlo + carry
@@ -50,6 +55,12 @@ func (s *SplitMix64) Seed(seed int64) {
 	*s = SplitMix64(seed)
 }
 
+// NewSplitMix64 returns a SplitMix64 with the given state.
+func NewSplitMix64(seed uint64) *SplitMix64 {
+	s := SplitMix64(seed)
+	return &s
+}
+
 func (s *SplitMix64) Uint64() uint64 {
 	*s += 0x9e3779b97f4a7c15
 	z := uint64(*s)
@@ -81,6 +92,13 @@ func (s *Xoshiro256ss) Seed(seed int64) {
 	s[3] = m.Uint64()
 }
 
+// NewXoshiro256ss returns a Xoshiro256ss with the given 256-bit state.
+// The state must not be all zeros, and it should be well-mixed since
+// poor seeds produce poor initial output.
+func NewXoshiro256ss(s0, s1, s2, s3 uint64) *Xoshiro256ss {
+	return &Xoshiro256ss{s0, s1, s2, s3}
+}
+
 func (s *Xoshiro256ss) Uint64() uint64 {
 	x := s[1] * 5
 	r := bits.RotateLeft64(x, 7) * 9
@@ -103,24 +121,13 @@ var jump = [4]uint64{
 	0xa9582618e03fc9aa, 0x39abdc4529b1661c,
 }
 
-// Jump is equivalent to 2^128 calls to Uint64().
+// Jump is equivalent to 2^128 calls to Uint64(). The first call builds
+// a 256kB lookup table so that subsequent jumps are cheap.
 func (s *Xoshiro256ss) Jump() {
-	var s0, s1, s2, s3 uint64
-	for _, j := range jump {
-		for b := uint(0); b < 64; b++ {
-			if j&(1<<b) != 0 {
-				s0 ^= s[0]
-				s1 ^= s[1]
-				s2 ^= s[2]
-				s3 ^= s[3]
-			}
-			s.Uint64()
-		}
-	}
-	s[0] = s0
-	s[1] = s1
-	s[2] = s2
-	s[3] = s3
+	jumpMatrixOnce.Do(func() {
+		jumpMatrix = newXoshiroMatrix(jump)
+	})
+	jumpMatrix.apply(s)
 }
 
 var longjump = [4]uint64{
@@ -128,24 +135,13 @@ var longjump = [4]uint64{
 	0x77710069854ee241, 0x39109bb02acbe635,
 }
 
-// LongJump is equivalent to 2^192 calls to Uint64().
+// LongJump is equivalent to 2^192 calls to Uint64(). The first call
+// builds a 256kB lookup table so that subsequent jumps are cheap.
 func (s *Xoshiro256ss) LongJump() {
-	var s0, s1, s2, s3 uint64
-	for _, j := range longjump {
-		for b := uint(0); b < 64; b++ {
-			if j&(1<<b) != 0 {
-				s0 ^= s[0]
-				s1 ^= s[1]
-				s2 ^= s[2]
-				s3 ^= s[3]
-			}
-			s.Uint64()
-		}
-	}
-	s[0] = s0
-	s[1] = s1
-	s[2] = s2
-	s[3] = s3
+	longjumpMatrixOnce.Do(func() {
+		longjumpMatrix = newXoshiroMatrix(longjump)
+	})
+	longjumpMatrix.apply(s)
 }
 
 // A Pcg32 provides a 32-bit permuted congruential generator that
@@ -160,10 +156,18 @@ func (s *Pcg32) Seed(seed int64) {
 	s.Uint32() // discard first output as it's essentially just the seed
 }
 
+// NewPcg32 returns a Pcg32 seeded like Seed, but accepting a full
+// 64-bit seed.
+func NewPcg32(seed uint64) *Pcg32 {
+	s := Pcg32(seed)
+	s.Uint32()
+	return &s
+}
+
 // Uint32 returns a uniformly random 32-bit integer.
 func (s *Pcg32) Uint32() uint32 {
 	p := uint64(*s)
-	*s = Pcg32(p*0x5851f42d4c957f2d + 0x14057b7ef767814f)
+	*s = Pcg32(p*pcg32M + pcg32A)
 	x := uint32((p>>18 ^ p) >> 27)
 	r := int(p >> 59)
 	return bits.RotateLeft32(x, -r)
@@ -190,12 +194,17 @@ func (s *Pcg64) Seed(seed int64) {
 	s.Hi = 0
 }
 
+// NewPcg64 returns a Pcg64 with the given 128-bit state.
+func NewPcg64(hi, lo uint64) *Pcg64 {
+	return &Pcg64{hi, lo}
+}
+
 func (s *Pcg64) Uint64() uint64 {
 	const (
-		mhi = 0x2360ed051fc65da4
-		mlo = 0x4385df649fccf645
-		ahi = 0x5851f42d4c957f2d
-		alo = 0x14057b7ef767814f
+		mhi = pcg64Mhi
+		mlo = pcg64Mlo
+		ahi = pcg64Ahi
+		alo = pcg64Alo
 	)
 	carry, lo := bits.Mul64(mlo, s.Lo)
 	hi := mhi*s.Lo + s.Hi*mlo + carry
@@ -203,6 +212,11 @@ func (s *Pcg64) Uint64() uint64 {
 	hi += ahi + carry
 	s.Lo = lo
 	s.Hi = hi
+	return pcg64Output(hi, lo)
+}
+
+// pcg64Output is the Pcg64 permutation of a 128-bit state.
+func pcg64Output(hi, lo uint64) uint64 {
 	lo, hi = lo^lo>>43^hi<<21, hi^hi>>43
 	r := int(hi>>60) + 45
 	return lo>>r | hi<<(64-r)
@@ -225,16 +239,25 @@ func (s *Pcg64x) Seed(seed int64) {
 	s.Hi = uint64(seed)
 }
 
+// NewPcg64x returns a Pcg64x with the given 128-bit state.
+func NewPcg64x(hi, lo uint64) *Pcg64x {
+	return &Pcg64x{hi, lo}
+}
+
 func (s *Pcg64x) Uint64() uint64 {
-	const m = 0xb47d5ba190fb0fa5
+	const m = pcg64xM
 	var c uint64
 	c, s.Lo = bits.Mul64(s.Lo, m)
 	s.Hi = s.Hi*m + c
 	s.Lo, c = bits.Add64(s.Lo, 1, 0)
 	s.Hi += c
-	r := s.Hi
+	return pcg64xOutput(s.Hi)
+}
+
+// pcg64xOutput is the Pcg64x xorshift-multiply permutation.
+func pcg64xOutput(r uint64) uint64 {
 	r ^= r >> 32
-	r *= m
+	r *= pcg64xM
 	return r
 }
 
@@ -253,6 +276,11 @@ func (s *Msws64) Seed(seed int64) {
 	*s = Msws64{v, v, v, v}
 }
 
+// NewMsws64 returns a Msws64 with the given 256-bit state.
+func NewMsws64(s0, s1, s2, s3 uint64) *Msws64 {
+	return &Msws64{s0, s1, s2, s3}
+}
+
 func (s *Msws64) Int63() int64 {
 	return int64(s.Uint64() >> 1)
 }
@@ -261,8 +289,8 @@ func (s *Msws64) Uint64() uint64 {
 	var xl, xh, wl, wh, c uint64
 	c, xl = bits.Mul64(s[0], s[0])
 	xh = 2*s[0]*s[1] + c
-	wl, c = bits.Add64(s[2], 0x8367589d496e8afd, 0)
-	wh = s[3] + 0x918fba1eff8e67e1 + c
+	wl, c = bits.Add64(s[2], msws64Klo, 0)
+	wh = s[3] + msws64Khi + c
 	xl, c = bits.Add64(xl, wl, 0)
 	xh = xh + wh + c
 	s[0] = xh
@@ -287,6 +315,12 @@ func (s *RomuDuo) Seed(seed int64) {
 	s.y = m.Uint64()
 }
 
+// NewRomuDuo returns a RomuDuo with the given 128-bit state. The state
+// must not be all zeros, and it should be well-mixed.
+func NewRomuDuo(x, y uint64) *RomuDuo {
+	return &RomuDuo{x, y}
+}
+
 func (s *RomuDuo) Int63() int64 {
 	return int64(s.Uint64() >> 1)
 }
@@ -314,6 +348,12 @@ func (s *RomuDuoJr) Seed(seed int64) {
 	s.y = m.Uint64()
 }
 
+// NewRomuDuoJr returns a RomuDuoJr with the given 128-bit state. The
+// state must not be all zeros, and it should be well-mixed.
+func NewRomuDuoJr(x, y uint64) *RomuDuoJr {
+	return &RomuDuoJr{x, y}
+}
+
 func (s *RomuDuoJr) Int63() int64 {
 	return int64(s.Uint64() >> 1)
 }
@@ -348,6 +388,16 @@ func (m *Mmlfg) Seed(seed int64) {
 	m.j = 12
 }
 
+// NewMmlfg returns a Mmlfg with the given state. Each element is forced
+// odd, and the state should be well-mixed.
+func NewMmlfg(seed [15]uint64) *Mmlfg {
+	m := &Mmlfg{i: 14, j: 12}
+	for i, v := range seed {
+		m.s[i] = v | 1
+	}
+	return m
+}
+
 func (m *Mmlfg) Int63() int64 {
 	return int64(m.Uint64() >> 1)
 }
@@ -383,12 +433,22 @@ func (m *Mwc256xxa64) Seed(seed int64) {
 	}
 }
 
+// NewMwc256xxa64 returns a Mwc256xxa64 seeded from a full 128-bit seed
+// following the reference implementation.
+func NewMwc256xxa64(x1, x2 uint64) *Mwc256xxa64 {
+	m := &Mwc256xxa64{x1, x2, 0xcafef00dd15ea5e5, 0x14057b7ef767814f}
+	for i := 0; i < 6; i++ {
+		m.Uint64()
+	}
+	return m
+}
+
 func (m *Mwc256xxa64) Int63() int64 {
 	return int64(m.Uint64() >> 1)
 }
 
 func (m *Mwc256xxa64) Uint64() uint64 {
-	hi, lo := bits.Mul64(0xfeb344657c0af413, m[2])
+	hi, lo := bits.Mul64(mwc256xxa64A, m[2])
 	r := (m[2] ^ m[1]) + (m[0] ^ hi)
 	t, c := bits.Add64(m[3], lo, 0)
 	m[2] = m[1]
@@ -414,6 +474,16 @@ func (s *Sfc64) Seed(seed int64) {
 	s[3] = m.Uint64()
 }
 
+// NewSfc64 returns an Sfc64 seeded from a full 192-bit seed following
+// the PractRand reference implementation.
+func NewSfc64(a, b, c uint64) *Sfc64 {
+	s := &Sfc64{a, b, c, 1}
+	for i := 0; i < 12; i++ {
+		s.Uint64()
+	}
+	return s
+}
+
 func (s *Sfc64) Int63() int64 {
 	return int64(s.Uint64() >> 1)
 }
@@ -23,6 +23,13 @@ func TestLcg128(t *testing.T) {
 				i, got, w)
 		}
 	}
+	for i := len(want) - 1; i >= 0; i-- {
+		got := r.Prev()
+		if got != want[i] {
+			t.Errorf("Lcg128.Prev(%d), got %#016x, want %#016x",
+				i, got, want[i])
+		}
+	}
 }
 
 func BenchmarkLcg128(b *testing.B) {
@@ -41,6 +48,15 @@ func BenchmarkLcg128Interface(b *testing.B) {
 	}
 }
 
+func BenchmarkLcg128Fill(b *testing.B) {
+	var r rng.Lcg128
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func TestSplitMix64(t *testing.T) {
 	want := []uint64{
 		0xe220a8397b1dcdaf, 0x6e789e6aa1b965f4, 0x06c45d188009454f,
@@ -75,6 +91,15 @@ func BenchmarkSplitMix64Interface(b *testing.B) {
 	}
 }
 
+func BenchmarkSplitMix64Fill(b *testing.B) {
+	var r rng.SplitMix64
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func TestXoshiro256ss(t *testing.T) {
 	// The bad initial output demonstrates why it's important to see
 	// this one very carefully. Fortunately it doesn't matter for this
@@ -145,6 +170,15 @@ func BenchmarkXoshiro256ssInterface(b *testing.B) {
 	}
 }
 
+func BenchmarkXoshiro256ssFill(b *testing.B) {
+	var r rng.Xoshiro256ss
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func TestPcg32(t *testing.T) {
 	// Output from official "Minimal C Implementation"
 	// seed = 0, inc = 0x14057b7ef767814f
@@ -163,6 +197,13 @@ func TestPcg32(t *testing.T) {
 				i, got, w)
 		}
 	}
+	for i := len(want) - 1; i >= 0; i-- {
+		got := r.PrevUint32()
+		if got != want[i] {
+			t.Errorf("Pcg32.PrevUint32(%d), got %#08x, want %#08x",
+				i, got, want[i])
+		}
+	}
 }
 
 func TestPcg64(t *testing.T) {
@@ -186,6 +227,13 @@ func TestPcg64(t *testing.T) {
 				i, got, w)
 		}
 	}
+	for i := len(want) - 1; i >= 0; i-- {
+		got := r.Prev()
+		if got != want[i] {
+			t.Errorf("Pcg64.Prev(%d), got %#016x, want %#016x",
+				i, got, want[i])
+		}
+	}
 }
 
 func TestPcg64x(t *testing.T) {
@@ -210,6 +258,13 @@ func TestPcg64x(t *testing.T) {
 				i, got, w)
 		}
 	}
+	for i := len(want) - 1; i >= 0; i-- {
+		got := r.Prev()
+		if got != want[i] {
+			t.Errorf("Pcg64x.Prev(%d), got %#016x, want %#016x",
+				i, got, want[i])
+		}
+	}
 }
 
 func TestMsws64(t *testing.T) {
@@ -363,6 +418,36 @@ func TestSfc64(t *testing.T) {
 	}
 }
 
+func TestConstructors(t *testing.T) {
+	// Constructors given the same seed as Seed() must agree with it.
+	var splitmix64 rng.SplitMix64
+	splitmix64.Seed(-1)
+	var pcg32 rng.Pcg32
+	pcg32.Seed(-1)
+	var mwc256xxa64 rng.Mwc256xxa64
+	mwc256xxa64.Seed(-1)
+
+	table := []struct {
+		name      string
+		got, want rand.Source64
+	}{
+		{"Lcg128", rng.NewLcg128(0, 1), &rng.Lcg128{Hi: 0, Lo: 1}},
+		{"SplitMix64", rng.NewSplitMix64(1<<64 - 1), &splitmix64},
+		{"Pcg32", rng.NewPcg32(1<<64 - 1), &pcg32},
+		{"Mwc256xxa64", rng.NewMwc256xxa64(1<<64-1, 1<<64-1), &mwc256xxa64},
+	}
+	for _, e := range table {
+		for i := 0; i < 8; i++ {
+			got := e.got.Uint64()
+			want := e.want.Uint64()
+			if got != want {
+				t.Errorf("New%s Uint64(%d), got %#016x, want %#016x",
+					e.name, i, got, want)
+			}
+		}
+	}
+}
+
 func BenchmarkPcg32(b *testing.B) {
 	var r rng.Pcg32
 	r.Seed(int64(b.N))
@@ -379,6 +464,15 @@ func BenchmarkPcg32Interface(b *testing.B) {
 	}
 }
 
+func BenchmarkPcg32Fill(b *testing.B) {
+	var r rng.Pcg32
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func BenchmarkPcg64(b *testing.B) {
 	var r rng.Pcg64
 	r.Seed(int64(b.N))
@@ -395,6 +489,15 @@ func BenchmarkPcg64Interface(b *testing.B) {
 	}
 }
 
+func BenchmarkPcg64Fill(b *testing.B) {
+	var r rng.Pcg64
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func BenchmarkPcg64x(b *testing.B) {
 	var r rng.Pcg64x
 	r.Seed(int64(b.N))
@@ -411,6 +514,15 @@ func BenchmarkPcg64xInterface(b *testing.B) {
 	}
 }
 
+func BenchmarkPcg64xFill(b *testing.B) {
+	var r rng.Pcg64x
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func BenchmarkMsws64(b *testing.B) {
 	var r rng.Msws64
 	r.Seed(int64(b.N))
@@ -427,6 +539,15 @@ func BenchmarkMsws64Interface(b *testing.B) {
 	}
 }
 
+func BenchmarkMsws64Fill(b *testing.B) {
+	var r rng.Msws64
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func BenchmarkRomuDuo(b *testing.B) {
 	var r rng.RomuDuo
 	r.Seed(int64(b.N))
@@ -443,6 +564,15 @@ func BenchmarkRomuDuoInterface(b *testing.B) {
 	}
 }
 
+func BenchmarkRomuDuoFill(b *testing.B) {
+	var r rng.RomuDuo
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func BenchmarkRomuDuoJr(b *testing.B) {
 	var r rng.RomuDuoJr
 	r.Seed(int64(b.N))
@@ -459,6 +589,15 @@ func BenchmarkRomuDuoJrInterface(b *testing.B) {
 	}
 }
 
+func BenchmarkRomuDuoJrFill(b *testing.B) {
+	var r rng.RomuDuoJr
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func BenchmarkMmlfg(b *testing.B) {
 	var r rng.Mmlfg
 	r.Seed(int64(b.N))
@@ -475,6 +614,15 @@ func BenchmarkMmlfgInterface(b *testing.B) {
 	}
 }
 
+func BenchmarkMmlfgFill(b *testing.B) {
+	var r rng.Mmlfg
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func BenchmarkMwc256xxa64(b *testing.B) {
 	var r rng.Mwc256xxa64
 	r.Seed(int64(b.N))
@@ -491,6 +639,15 @@ func BenchmarkMwc256xxa64Interface(b *testing.B) {
 	}
 }
 
+func BenchmarkMwc256xxa64Fill(b *testing.B) {
+	var r rng.Mwc256xxa64
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func BenchmarkSfc64(b *testing.B) {
 	var r rng.Sfc64
 	r.Seed(int64(b.N))
@@ -507,6 +664,15 @@ func BenchmarkSfc64Interface(b *testing.B) {
 	}
 }
 
+func BenchmarkSfc64Fill(b *testing.B) {
+	var r rng.Sfc64
+	r.Seed(int64(b.N))
+	buf := make([]uint64, 1024)
+	for i := 0; i < b.N; i += len(buf) {
+		r.Fill(buf)
+	}
+}
+
 func BenchmarkBaseline(b *testing.B) {
 	// This test isn't entirely fair since it's being done through an
 	// interface, but since the concrete implementation isn't exported
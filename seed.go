@@ -0,0 +1,247 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+// seedExpand fills dst with words derived from an arbitrary-length key
+// so that every word of dst depends on every word of key. The key is
+// absorbed through a chain of bijective mixes, then the chain is run
+// once more across dst.
+func seedExpand(dst, key []uint64) {
+	h := mix64(uint64(len(key)) + goldenGamma)
+	for i := range dst {
+		dst[i] = 0
+	}
+	n := len(key)
+	if n < len(dst) {
+		n = len(dst)
+	}
+	for i := 0; i < n+len(dst); i++ {
+		if i < len(key) {
+			h ^= key[i]
+		}
+		h = mix64(h + goldenGamma)
+		dst[i%len(dst)] ^= h
+	}
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key material.
+func (s *Lcg128) SeedUint64s(key ...uint64) {
+	var w [2]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Lcg128) setWords(w []uint64) {
+	s.Hi, s.Lo = w[0], w[1]
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key material.
+func (s *SplitMix64) SeedUint64s(key ...uint64) {
+	var w [1]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *SplitMix64) setWords(w []uint64) {
+	*s = SplitMix64(w[0])
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key material.
+func (s *SplittableRandom) SeedUint64s(key ...uint64) {
+	var w [2]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *SplittableRandom) setWords(w []uint64) {
+	s.State, s.Gamma = w[0], mixGamma(w[1])
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key
+// material, avoiding the all-zero state.
+func (s *Xoshiro256ss) SeedUint64s(key ...uint64) {
+	var w [4]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Xoshiro256ss) setWords(w []uint64) {
+	copy(s[:], w)
+	if s[0]|s[1]|s[2]|s[3] == 0 {
+		s[0] = goldenGamma
+	}
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key material.
+func (s *Pcg32) SeedUint64s(key ...uint64) {
+	var w [1]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Pcg32) setWords(w []uint64) {
+	*s = Pcg32(w[0])
+}
+
+// SeedUint64s seeds both the state and the stream from arbitrary-length
+// key material.
+func (s *Pcg32Stream) SeedUint64s(key ...uint64) {
+	var w [2]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Pcg32Stream) setWords(w []uint64) {
+	s.State, s.Inc = w[0], w[1]|1
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key material.
+func (s *Pcg64) SeedUint64s(key ...uint64) {
+	var w [2]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Pcg64) setWords(w []uint64) {
+	s.Hi, s.Lo = w[0], w[1]
+}
+
+// SeedUint64s seeds both the state and the stream from arbitrary-length
+// key material.
+func (s *Pcg64Stream) SeedUint64s(key ...uint64) {
+	var w [4]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Pcg64Stream) setWords(w []uint64) {
+	s.Hi, s.Lo, s.IncHi, s.IncLo = w[0], w[1], w[2], w[3]|1
+}
+
+// SeedUint64s seeds both the state and the stream from arbitrary-length
+// key material.
+func (s *Pcg64Dxsm) SeedUint64s(key ...uint64) {
+	var w [4]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Pcg64Dxsm) setWords(w []uint64) {
+	s.Hi, s.Lo, s.IncHi, s.IncLo = w[0], w[1], w[2], w[3]|1
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key material.
+func (s *Pcg64x) SeedUint64s(key ...uint64) {
+	var w [2]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Pcg64x) setWords(w []uint64) {
+	s.Hi, s.Lo = w[0], w[1]
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key material.
+func (s *Msws64) SeedUint64s(key ...uint64) {
+	var w [4]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Msws64) setWords(w []uint64) {
+	copy(s[:], w)
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key
+// material, avoiding the all-zero state.
+func (s *RomuDuo) SeedUint64s(key ...uint64) {
+	var w [2]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *RomuDuo) setWords(w []uint64) {
+	s.x, s.y = w[0], w[1]
+	if s.x|s.y == 0 {
+		s.x = goldenGamma
+	}
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key
+// material, avoiding the all-zero state.
+func (s *RomuDuoJr) SeedUint64s(key ...uint64) {
+	var w [2]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *RomuDuoJr) setWords(w []uint64) {
+	s.x, s.y = w[0], w[1]
+	if s.x|s.y == 0 {
+		s.x = goldenGamma
+	}
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key
+// material, forcing every element odd.
+func (m *Mmlfg) SeedUint64s(key ...uint64) {
+	var w [15]uint64
+	seedExpand(w[:], key)
+	m.setWords(w[:])
+}
+
+func (m *Mmlfg) setWords(w []uint64) {
+	for i := range m.s {
+		m.s[i] = w[i] | 1
+	}
+	m.i = 14
+	m.j = 12
+}
+
+// mwc256xxa64A is the Mwc256xxa64 multiplier.
+const mwc256xxa64A = 0xfeb344657c0af413
+
+// mwc256xxa64Valid reports whether w is a valid Mwc256xxa64 state: the
+// carry is less than the multiplier, and the state is not one of the
+// generator's two fixed points, all zero or all ones with carry a - 1.
+func mwc256xxa64Valid(w []uint64) bool {
+	const max = 1<<64 - 1
+	switch {
+	case w[3] >= mwc256xxa64A:
+		return false
+	case w[0]|w[1]|w[2]|w[3] == 0:
+		return false
+	case w[0]&w[1]&w[2] == max && w[3] == mwc256xxa64A-1:
+		return false
+	}
+	return true
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key
+// material. The carry is reduced modulo the multiplier, and either
+// fixed point of the generator is replaced by setting the carry to 1,
+// so the result is always a state UnmarshalBinary accepts.
+func (m *Mwc256xxa64) SeedUint64s(key ...uint64) {
+	var w [4]uint64
+	seedExpand(w[:], key)
+	m.setWords(w[:])
+}
+
+func (m *Mwc256xxa64) setWords(w []uint64) {
+	copy(m[:], w)
+	m[3] %= mwc256xxa64A
+	if !mwc256xxa64Valid(m[:]) {
+		m[3] = 1
+	}
+}
+
+// SeedUint64s seeds the entire state from arbitrary-length key material.
+func (s *Sfc64) SeedUint64s(key ...uint64) {
+	var w [4]uint64
+	seedExpand(w[:], key)
+	s.setWords(w[:])
+}
+
+func (s *Sfc64) setWords(w []uint64) {
+	copy(s[:], w)
+}
@@ -0,0 +1,81 @@
+package rng_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+type seeder interface {
+	rand.Source64
+	SeedUint64s(...uint64)
+}
+
+func TestSeedUint64s(t *testing.T) {
+	long := make([]uint64, 40)
+	for i := range long {
+		long[i] = uint64(i)
+	}
+	keys := [][]uint64{nil, {0}, {0, 0}, {1}, long}
+	for _, name := range rng.Names() {
+		info := rng.Registry[name]
+		if _, ok := info.New(0).(seeder); !ok {
+			t.Errorf("%s does not implement SeedUint64s", name)
+			continue
+		}
+		newSeeder := func() seeder { return info.New(0).(seeder) }
+		seen := make(map[uint64]int)
+		for k, key := range keys {
+			a, b := newSeeder(), newSeeder()
+			a.SeedUint64s(key...)
+			b.SeedUint64s(key...)
+			x := a.Uint64()
+			if x != b.Uint64() {
+				t.Errorf("%s.SeedUint64s(%d) not reproducible", name, k)
+			}
+			if j, ok := seen[x]; ok {
+				t.Errorf("%s.SeedUint64s(%d) same as key %d",
+					name, k, j)
+			}
+			seen[x] = k
+		}
+
+		// The final word of a long key must still matter.
+		a, b := newSeeder(), newSeeder()
+		a.SeedUint64s(long...)
+		long[len(long)-1]++
+		b.SeedUint64s(long...)
+		long[len(long)-1]--
+		if a.Uint64() == b.Uint64() {
+			t.Errorf("%s.SeedUint64s() ignores the end of a long key",
+				name)
+		}
+	}
+}
+
+func TestSeedUint64sInvalid(t *testing.T) {
+	var x rng.Xoshiro256ss
+	var m rng.Mwc256xxa64
+	for i := uint64(0); i < 1000; i++ {
+		x.SeedUint64s(i)
+		if x == (rng.Xoshiro256ss{}) {
+			t.Errorf("Xoshiro256ss.SeedUint64s(%d) is all zero", i)
+		}
+		m.SeedUint64s(i)
+		buf, _ := m.MarshalBinary()
+		if err := new(rng.Mwc256xxa64).UnmarshalBinary(buf); err != nil {
+			t.Errorf("Mwc256xxa64.SeedUint64s(%d) invalid state %#x", i, m)
+		}
+	}
+
+	// An Mmlfg with even elements degenerates to zero output, so
+	// check that its output stays odd-derived and nonzero.
+	var r rng.Mmlfg
+	r.SeedUint64s()
+	for i := 0; i < 1000; i++ {
+		if r.Uint64() == 0 {
+			t.Fatalf("Mmlfg.SeedUint64s() produced a degenerate state")
+		}
+	}
+}
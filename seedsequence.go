@@ -0,0 +1,245 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+// SeedSequence constants, matching NumPy's numpy.random.SeedSequence.
+const (
+	seedSeqPoolSize = 4
+	seedSeqInitA    = 0x43b0d7e5
+	seedSeqMultA    = 0x931e8875
+	seedSeqInitB    = 0x8b51f9dd
+	seedSeqMultB    = 0x58f38ded
+	seedSeqMixMultL = 0xca01f9dd
+	seedSeqMixMultR = 0x4973f715
+	seedSeqXShift   = 16
+)
+
+// A SeedSequence mixes entropy into seeds for the generators in this
+// package, reproducing numpy.random.SeedSequence bit-for-bit. Its
+// Entropy and SpawnKey are exported so that a sequence can be logged
+// and recreated, including from Python: a struct literal with the same
+// fields generates the same seeds. The entropy pool is mixed from them
+// on each use, so they may be set or changed at any time.
+type SeedSequence struct {
+	// Entropy is the run entropy as 32-bit words, least significant
+	// first, following NumPy's integer coercion rules.
+	Entropy []uint32
+
+	// SpawnKey identifies this sequence's position in the spawn tree.
+	SpawnKey []uint64
+
+	spawned uint64
+}
+
+// NewSeedSequence returns a SeedSequence equivalent to NumPy's
+// SeedSequence(entropy), where entropy is an integer (one argument) or
+// a list of integers. With no arguments, 128 bits are drawn from
+// the operating system just like NumPy does.
+func NewSeedSequence(entropy ...uint64) *SeedSequence {
+	s := new(SeedSequence)
+	if len(entropy) == 0 {
+		// NumPy coerces a single 128-bit integer.
+		w := entropyWords(2)
+		s.Entropy = appendUint32s(nil, w[0], w[1])
+	} else {
+		for _, e := range entropy {
+			s.Entropy = appendUint32s(s.Entropy, e)
+		}
+	}
+	return s
+}
+
+// appendUint32s appends the multi-word integer n, least significant
+// word first, as 32-bit words. As with NumPy, zero is a single word
+// and leading zero words are dropped.
+func appendUint32s(dst []uint32, n ...uint64) []uint32 {
+	var w []uint32
+	for _, v := range n {
+		w = append(w, uint32(v), uint32(v>>32))
+	}
+	for len(w) > 1 && w[len(w)-1] == 0 {
+		w = w[:len(w)-1]
+	}
+	return append(dst, w...)
+}
+
+func seedSeqMix(x, y uint32) uint32 {
+	r := seedSeqMixMultL*x - seedSeqMixMultR*y
+	return r ^ r>>seedSeqXShift
+}
+
+// assembledEntropy returns the run entropy followed by the spawn key,
+// with the run entropy zero-padded to the pool size when there is a
+// spawn key.
+func (s *SeedSequence) assembledEntropy() []uint32 {
+	e := append([]uint32(nil), s.Entropy...)
+	if len(s.SpawnKey) > 0 {
+		for len(e) < seedSeqPoolSize {
+			e = append(e, 0)
+		}
+	}
+	for _, k := range s.SpawnKey {
+		e = appendUint32s(e, k)
+	}
+	return e
+}
+
+// mixEntropy returns the entropy pool, mixed from the run entropy and
+// spawn key.
+func (s *SeedSequence) mixEntropy() (pool [seedSeqPoolSize]uint32) {
+	h := uint32(seedSeqInitA)
+	hashmix := func(v uint32) uint32 {
+		v ^= h
+		h *= seedSeqMultA
+		v *= h
+		return v ^ v>>seedSeqXShift
+	}
+
+	e := s.assembledEntropy()
+	for i := range pool {
+		var v uint32
+		if i < len(e) {
+			v = e[i]
+		}
+		pool[i] = hashmix(v)
+	}
+	for src := range pool {
+		for dst := range pool {
+			if src != dst {
+				pool[dst] = seedSeqMix(pool[dst], hashmix(pool[src]))
+			}
+		}
+	}
+	for src := len(pool); src < len(e); src++ {
+		for dst := range pool {
+			pool[dst] = seedSeqMix(pool[dst], hashmix(e[src]))
+		}
+	}
+	return pool
+}
+
+// GenerateState returns n 32-bit words of seed material, like
+// SeedSequence.generate_state(n, np.uint32).
+func (s *SeedSequence) GenerateState(n int) []uint32 {
+	pool := s.mixEntropy()
+	h := uint32(seedSeqInitB)
+	state := make([]uint32, n)
+	for i := range state {
+		v := pool[i%len(pool)]
+		v ^= h
+		h *= seedSeqMultB
+		v *= h
+		state[i] = v ^ v>>seedSeqXShift
+	}
+	return state
+}
+
+// GenerateState64 returns n 64-bit words of seed material, like
+// SeedSequence.generate_state(n, np.uint64).
+func (s *SeedSequence) GenerateState64(n int) []uint64 {
+	w := s.GenerateState(2 * n)
+	state := make([]uint64, n)
+	for i := range state {
+		state[i] = uint64(w[2*i+1])<<32 | uint64(w[2*i])
+	}
+	return state
+}
+
+// Spawn returns n child sequences, like SeedSequence.spawn(n). Each
+// child has the same entropy and a distinct spawn key, and successive
+// calls continue numbering where the last left off.
+func (s *SeedSequence) Spawn(n int) []*SeedSequence {
+	children := make([]*SeedSequence, n)
+	for i := range children {
+		key := make([]uint64, len(s.SpawnKey)+1)
+		copy(key, s.SpawnKey)
+		key[len(s.SpawnKey)] = s.spawned
+		s.spawned++
+		entropy := append([]uint32(nil), s.Entropy...)
+		children[i] = &SeedSequence{Entropy: entropy, SpawnKey: key}
+	}
+	return children
+}
+
+// SeedFrom seeds the generator like NumPy's PCG64 constructor.
+func (s *Pcg64Stream) SeedFrom(ss *SeedSequence) {
+	w := ss.GenerateState64(4)
+	s.SeedStream(w[0], w[1], w[2], w[3])
+}
+
+// SeedFrom seeds the generator like NumPy's PCG64DXSM constructor.
+func (s *Pcg64Dxsm) SeedFrom(ss *SeedSequence) {
+	w := ss.GenerateState64(4)
+	s.SeedStream(w[0], w[1], w[2], w[3])
+}
+
+// SeedFrom seeds the generator like NumPy's SFC64 constructor.
+func (s *Sfc64) SeedFrom(ss *SeedSequence) {
+	w := ss.GenerateState64(3)
+	*s = *NewSfc64(w[0], w[1], w[2])
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *Lcg128) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(2))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *SplitMix64) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(1))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *SplittableRandom) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(2))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *Xoshiro256ss) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(4))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *Pcg32) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(1))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *Pcg32Stream) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(2))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *Pcg64) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(2))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *Pcg64x) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(2))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *Msws64) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(4))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *RomuDuo) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(2))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (s *RomuDuoJr) SeedFrom(ss *SeedSequence) {
+	s.setWords(ss.GenerateState64(2))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (m *Mmlfg) SeedFrom(ss *SeedSequence) {
+	m.setWords(ss.GenerateState64(15))
+}
+
+// SeedFrom fills the state directly with words from a SeedSequence.
+func (m *Mwc256xxa64) SeedFrom(ss *SeedSequence) {
+	m.setWords(ss.GenerateState64(4))
+}
@@ -0,0 +1,88 @@
+package rng_test
+
+import (
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestSeedSequence(t *testing.T) {
+	// Reference data from NumPy's test_seed_sequence.py
+	s := rng.NewSeedSequence(3735928559, 195939070, 229505742, 305419896)
+	want := []uint32{3914649087, 576849849, 3593928901, 2229911004}
+	for i, got := range s.GenerateState(len(want)) {
+		if got != want[i] {
+			t.Errorf("SeedSequence.GenerateState(%d), got %d, want %d",
+				i, got, want[i])
+		}
+	}
+
+	// 64-bit words are pairs of 32-bit words, low word first.
+	w32 := s.GenerateState(4)
+	w64 := s.GenerateState64(2)
+	for i, got := range w64 {
+		want := uint64(w32[2*i+1])<<32 | uint64(w32[2*i])
+		if got != want {
+			t.Errorf("SeedSequence.GenerateState64(%d), got %#016x, want %#016x",
+				i, got, want)
+		}
+	}
+}
+
+func TestSeedSequenceSpawn(t *testing.T) {
+	s := rng.NewSeedSequence(12345)
+	children := append(s.Spawn(2), s.Spawn(1)...)
+	seen := make(map[uint64]bool)
+	for i, c := range children {
+		if len(c.SpawnKey) != 1 || c.SpawnKey[0] != uint64(i) {
+			t.Errorf("SeedSequence.Spawn(%d), got key %v", i, c.SpawnKey)
+		}
+		w := c.GenerateState64(1)[0]
+		if seen[w] {
+			t.Errorf("SeedSequence.Spawn(%d) repeated state", i)
+		}
+		seen[w] = true
+	}
+	grandchild := children[1].Spawn(1)[0]
+	if k := grandchild.SpawnKey; len(k) != 2 || k[0] != 1 || k[1] != 0 {
+		t.Errorf("SeedSequence.Spawn() grandchild key %v", k)
+	}
+
+	// Children do not share the parent's entropy slice.
+	s.Entropy[0] ^= 1
+	if children[0].Entropy[0] == s.Entropy[0] {
+		t.Errorf("SeedSequence.Spawn() aliased the parent's entropy")
+	}
+}
+
+func TestSeedSequenceLiteral(t *testing.T) {
+	// A sequence recreated from its exported fields generates the same
+	// seeds, including for spawned children.
+	s := rng.NewSeedSequence(12345)
+	c := s.Spawn(3)[2]
+	for _, orig := range []*rng.SeedSequence{s, c} {
+		lit := rng.SeedSequence{Entropy: orig.Entropy, SpawnKey: orig.SpawnKey}
+		want := orig.GenerateState64(4)
+		for i, got := range lit.GenerateState64(4) {
+			if got != want[i] {
+				t.Errorf("SeedSequence%v literal GenerateState64(%d), got %#016x, want %#016x",
+					orig.SpawnKey, i, got, want[i])
+			}
+		}
+	}
+}
+
+func TestSeedSequenceNumPy(t *testing.T) {
+	// First outputs of NumPy's PCG64(0xdeadbeaf) and
+	// PCG64DXSM(0xdeadbeaf), from NumPy's test data
+	var pcg64 rng.Pcg64Stream
+	pcg64.SeedFrom(rng.NewSeedSequence(0xdeadbeaf))
+	if got, want := pcg64.Uint64(), uint64(0x60d24054e17a0698); got != want {
+		t.Errorf("PCG64 Uint64(), got %#016x, want %#016x", got, want)
+	}
+	var dxsm rng.Pcg64Dxsm
+	dxsm.SeedFrom(rng.NewSeedSequence(0xdeadbeaf))
+	if got, want := dxsm.Uint64(), uint64(0xdf1ddcf1e22521fe); got != want {
+		t.Errorf("PCG64DXSM Uint64(), got %#016x, want %#016x", got, want)
+	}
+}
@@ -0,0 +1,131 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math"
+)
+
+// The functions in this file do not mirror math/rand, whose Shuffle and
+// Perm go through Int31n. They draw every index with Uint64n, so they
+// are unbiased for any length, and their results depend only on the
+// generator state, never on the platform's int size.
+
+// Shuffle pseudo-randomizes the order of n elements with a Fisher-Yates
+// shuffle, calling swap to exchange the elements with indices i and j.
+// It panics if n < 0.
+func Shuffle[G Generator](g G, n int, swap func(i, j int)) {
+	if n < 0 {
+		panic("invalid argument to Shuffle")
+	}
+	for i := n - 1; i > 0; i-- {
+		j := int(Uint64n(g, uint64(i)+1))
+		swap(i, j)
+	}
+}
+
+// Perm returns a pseudo-random permutation of the integers [0, n),
+// built with the "inside-out" Fisher-Yates shuffle. It panics if n < 0.
+func Perm[G Generator](g G, n int) []int {
+	if n < 0 {
+		panic("invalid argument to Perm")
+	}
+	m := make([]int, n)
+	for i := range m {
+		j := int(Uint64n(g, uint64(i)+1))
+		m[i] = m[j]
+		m[j] = i
+	}
+	return m
+}
+
+// SampleK returns k distinct integers chosen uniformly from [0, n)
+// using Floyd's algorithm, which draws exactly k numbers. Every
+// k-subset is equally likely, but the order of the result is not
+// uniformly random; Shuffle it if that matters. It panics if k < 0 or
+// k > n.
+//
+// Bentley and Floyd, "Programming Pearls: A Sample of Brilliance"
+// (1987).
+func SampleK[G Generator](g G, n, k int) []int {
+	if k < 0 || k > n {
+		panic("invalid argument to SampleK")
+	}
+	s := make([]int, 0, k)
+	seen := make(map[int]struct{}, k)
+	for j := n - k; j < n; j++ {
+		t := int(Uint64n(g, uint64(j)+1))
+		if _, ok := seen[t]; ok {
+			t = j
+		}
+		seen[t] = struct{}{}
+		s = append(s, t)
+	}
+	return s
+}
+
+// A Reservoir holds a uniform random sample of up to k items from a
+// stream of unknown length, using Li's Algorithm L, "Reservoir-Sampling
+// Algorithms of Time Complexity O(n(1 + log(N/n)))" (1994). Rather than
+// drawing a number for every item, it draws how many items to skip, so
+// after the reservoir fills, the generator is consulted only
+// O(k log(N/k)) times over N items. The skip lengths are computed with
+// portable logarithms and exponentials, so a seed gives the same
+// sample on every platform.
+type Reservoir[T any, G Generator] struct {
+	g     G
+	items []T
+	k     int
+	n     int64   // items seen
+	next  int64   // value of n at which the next item is taken
+	w     float64 // largest key in the reservoir, in Li's formulation
+}
+
+// NewReservoir returns an empty reservoir of capacity k drawing from g.
+// It panics if k < 1.
+func NewReservoir[T any, G Generator](g G, k int) *Reservoir[T, G] {
+	if k < 1 {
+		panic("invalid argument to NewReservoir")
+	}
+	return &Reservoir[T, G]{g: g, items: make([]T, 0, k), k: k}
+}
+
+// Add offers the next item of the stream to the reservoir.
+func (r *Reservoir[T, G]) Add(item T) {
+	r.n++
+	if len(r.items) < r.k {
+		r.items = append(r.items, item)
+		if len(r.items) == r.k {
+			r.w = portableExp(portableLog(OpenFloat64(r.g)) / float64(r.k))
+			r.skip()
+		}
+		return
+	}
+	if r.n < r.next {
+		return
+	}
+	r.items[Uint64n(r.g, uint64(r.k))] = item
+	r.w *= portableExp(portableLog(OpenFloat64(r.g)) / float64(r.k))
+	r.skip()
+}
+
+// skip chooses the next item to take.
+func (r *Reservoir[T, G]) skip() {
+	s := math.Floor(portableLog(OpenFloat64(r.g)) / portableLog1p(-r.w))
+	if s >= float64(math.MaxInt64-r.n) {
+		r.next = math.MaxInt64
+		return
+	}
+	r.next = r.n + int64(s) + 1
+}
+
+// Items returns the current sample, in no particular order. The slice
+// is owned by the reservoir and changes with later calls to Add.
+func (r *Reservoir[T, G]) Items() []T {
+	return r.items
+}
+
+// Count returns the number of items offered so far.
+func (r *Reservoir[T, G]) Count() int64 {
+	return r.n
+}
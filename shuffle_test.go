@@ -0,0 +1,190 @@
+package rng_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+// Results are pinned so that a change in the sampling algorithms, which
+// would break reproducibility for users, cannot go unnoticed.
+func TestShuffleKnownAnswer(t *testing.T) {
+	s := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
+	rng.Shuffle(rng.NewSfc64(1, 2, 3), len(s), func(i, j int) {
+		s[i], s[j] = s[j], s[i]
+	})
+	want := []int{10, 1, 11, 7, 6, 9, 5, 8, 2, 4, 0, 3}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("Shuffle(), got %v, want %v", s, want)
+	}
+
+	p := rng.Perm(rng.NewSfc64(1, 2, 3), 12)
+	want = []int{1, 4, 0, 2, 8, 6, 10, 3, 5, 11, 9, 7}
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("Perm(), got %v, want %v", p, want)
+	}
+
+	k := rng.SampleK(rng.NewSfc64(1, 2, 3), 1000, 8)
+	want = []int{263, 9, 437, 252, 298, 754, 746, 802}
+	if !reflect.DeepEqual(k, want) {
+		t.Errorf("SampleK(), got %v, want %v", k, want)
+	}
+
+	r := rng.NewReservoir[int](rng.NewSfc64(1, 2, 3), 5)
+	for i := 0; i < 1000; i++ {
+		r.Add(i)
+	}
+	want = []int{516, 163, 452, 913, 66}
+	if !reflect.DeepEqual(r.Items(), want) {
+		t.Errorf("Reservoir.Items(), got %v, want %v", r.Items(), want)
+	}
+
+	// A long stream, where nearly every item is skipped, exercises the
+	// portable skip computation many times over.
+	r = rng.NewReservoir[int](rng.NewSfc64(1, 2, 3), 3)
+	for i := 0; i < 1000000; i++ {
+		r.Add(i)
+	}
+	want = []int{688915, 763901, 971388}
+	if !reflect.DeepEqual(r.Items(), want) {
+		t.Errorf("Reservoir.Items(), got %v, want %v", r.Items(), want)
+	}
+}
+
+// checkUniform runs a chi-square test that counts are all equal.
+func checkUniform(t *testing.T, name string, counts map[[4]int]int, bins int) {
+	t.Helper()
+	if len(counts) != bins {
+		t.Errorf("%s, got %d distinct outcomes, want %d",
+			name, len(counts), bins)
+		return
+	}
+	var total int
+	observed := make([]int, 0, bins)
+	for _, c := range counts {
+		observed = append(observed, c)
+		total += c
+	}
+	expected := make([]float64, bins)
+	for i := range expected {
+		expected[i] = float64(total) / float64(bins)
+	}
+	if x := chiSquare(observed, expected); !chiSquareOK(x, bins-1) {
+		t.Errorf("%s chi-square, got %v", name, x)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	const trials = 240000
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	shuffles := make(map[[4]int]int)
+	perms := make(map[[4]int]int)
+	for i := 0; i < trials; i++ {
+		s := [4]int{0, 1, 2, 3}
+		rng.Shuffle(r, len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+		shuffles[s]++
+
+		var p [4]int
+		copy(p[:], rng.Perm(r, 4))
+		perms[p]++
+	}
+	checkUniform(t, "Shuffle()", shuffles, 24)
+	checkUniform(t, "Perm()", perms, 24)
+
+	if p := rng.Perm(r, 0); len(p) != 0 {
+		t.Errorf("Perm(0), got %v", p)
+	}
+	rng.Shuffle(r, 1, func(i, j int) { t.Errorf("Shuffle(1) swapped") })
+}
+
+func TestSampleK(t *testing.T) {
+	const trials = 200000
+	r := rng.NewPcg64(1, 2)
+	subsets := make(map[[4]int]int)
+	for i := 0; i < trials; i++ {
+		s := rng.SampleK(r, 7, 3)
+		sort.Ints(s)
+		var key [4]int
+		copy(key[:], s)
+		subsets[key]++
+	}
+	checkUniform(t, "SampleK(7, 3)", subsets, 35)
+
+	s := rng.SampleK(r, 10, 10)
+	sort.Ints(s)
+	if !reflect.DeepEqual(s, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Errorf("SampleK(10, 10), got %v", s)
+	}
+	if s := rng.SampleK(r, 5, 0); len(s) != 0 {
+		t.Errorf("SampleK(5, 0), got %v", s)
+	}
+}
+
+func TestReservoir(t *testing.T) {
+	// Each 3-subset of a 7-item stream is equally likely.
+	const trials = 200000
+	r := rng.NewSfc64(1, 2, 3)
+	subsets := make(map[[4]int]int)
+	for i := 0; i < trials; i++ {
+		res := rng.NewReservoir[int](r, 3)
+		for j := 0; j < 7; j++ {
+			res.Add(j)
+		}
+		s := append([]int(nil), res.Items()...)
+		sort.Ints(s)
+		var key [4]int
+		copy(key[:], s)
+		subsets[key]++
+	}
+	checkUniform(t, "Reservoir(3) of 7", subsets, 35)
+
+	// Over long streams, where most items are skipped, every position
+	// is still included equally often.
+	const n, k = 2000, 10
+	counts := make([]int, n)
+	for i := 0; i < 5000; i++ {
+		res := rng.NewReservoir[int](r, k)
+		for j := 0; j < n; j++ {
+			res.Add(j)
+		}
+		if res.Count() != n {
+			t.Fatalf("Count(), got %d, want %d", res.Count(), n)
+		}
+		for _, v := range res.Items() {
+			counts[v]++
+		}
+	}
+	expected := make([]float64, n)
+	for i := range expected {
+		expected[i] = 5000.0 * k / n
+	}
+	if x := chiSquare(counts, expected); !chiSquareOK(x, n-1) {
+		t.Errorf("Reservoir(%d) of %d chi-square, got %v", k, n, x)
+	}
+
+	// Short streams are kept whole.
+	res := rng.NewReservoir[string](r, 5)
+	res.Add("a")
+	res.Add("b")
+	if got := res.Items(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Items(), got %v", got)
+	}
+}
+
+func BenchmarkShuffle(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	s := make([]int, 1000)
+	for i := 0; i < b.N; i += len(s) {
+		rng.Shuffle(r, len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+	}
+}
+
+func BenchmarkReservoir(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	res := rng.NewReservoir[int](r, 100)
+	for i := 0; i < b.N; i++ {
+		res.Add(i)
+	}
+}
@@ -0,0 +1,68 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math/bits"
+	"math/rand"
+)
+
+// A SplittableRandom provides the SplittableRandom algorithm of Steele,
+// Lea, and Flood, "Fast Splittable Pseudorandom Number Generators"
+// (2014), and implements math/rand.Source64. Unlike SplitMix64, each
+// instance has its own gamma, so Split() can derive child generators
+// that are statistically independent of their parent. The gamma must be
+// odd, so the Seed() method or NewSplittableRandom is recommended.
+type SplittableRandom struct{ State, Gamma uint64 }
+
+var _ rand.Source64 = (*SplittableRandom)(nil)
+
+const goldenGamma = 0x9e3779b97f4a7c15
+
+// NewSplittableRandom returns a SplittableRandom with the given seed
+// and the default gamma, matching java.util.SplittableRandom.
+func NewSplittableRandom(seed uint64) *SplittableRandom {
+	return &SplittableRandom{seed, goldenGamma}
+}
+
+func (s *SplittableRandom) Seed(seed int64) {
+	s.State = uint64(seed)
+	s.Gamma = goldenGamma
+}
+
+func (s *SplittableRandom) Uint64() uint64 {
+	s.State += s.Gamma
+	return mix64(s.State)
+}
+
+func (s *SplittableRandom) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+// Split returns a new generator, statistically independent of s, whose
+// seed and gamma are drawn from s.
+func (s *SplittableRandom) Split() *SplittableRandom {
+	seed := s.Uint64()
+	s.State += s.Gamma
+	return &SplittableRandom{seed, mixGamma(s.State)}
+}
+
+// mix64 is variant 13 of Stafford's MurmurHash3 finalizers, the same
+// function used by SplitMix64.
+func mix64(z uint64) uint64 {
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return z ^ z>>31
+}
+
+// mixGamma derives an odd gamma. Gammas with too few bit transitions
+// make poor Weyl sequences, so those are fixed up.
+func mixGamma(z uint64) uint64 {
+	z = (z ^ z>>33) * 0xff51afd7ed558ccd
+	z = (z ^ z>>33) * 0xc4ceb9fe1a85ec53
+	z = (z ^ z>>33) | 1
+	if bits.OnesCount64(z^z>>1) < 24 {
+		z ^= 0xaaaaaaaaaaaaaaaa
+	}
+	return z
+}
@@ -0,0 +1,65 @@
+package rng_test
+
+import (
+	"math/bits"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestSplittableRandom(t *testing.T) {
+	// With the default gamma it is identical to SplitMix64.
+	r := rng.NewSplittableRandom(0)
+	m := rng.SplitMix64(0)
+	for i := 0; i < 16; i++ {
+		got, want := r.Uint64(), m.Uint64()
+		if got != want {
+			t.Errorf("SplittableRandom.Uint64(%d), got %#016x, want %#016x",
+				i, got, want)
+		}
+	}
+}
+
+func TestSplittableRandomSplit(t *testing.T) {
+	parent := rng.NewSplittableRandom(0)
+	seen := make(map[uint64]bool)
+	for i := 0; i < 1000; i++ {
+		child := parent.Split()
+		g := child.Gamma
+		if g&1 == 0 {
+			t.Errorf("Split(%d) gamma %#016x is even", i, g)
+		}
+		if n := bits.OnesCount64(g ^ g>>1); n < 24 {
+			t.Errorf("Split(%d) gamma %#016x has %d transitions",
+				i, g, n)
+		}
+		if seen[g] {
+			t.Errorf("Split(%d) repeated gamma %#016x", i, g)
+		}
+		seen[g] = true
+	}
+
+	// Splitting is deterministic given the parent state.
+	a := rng.NewSplittableRandom(12345)
+	b := rng.NewSplittableRandom(12345)
+	ca, cb := a.Split().Split(), b.Split().Split()
+	for i := 0; i < 16; i++ {
+		if ca.Uint64() != cb.Uint64() {
+			t.Fatalf("Split() is not reproducible")
+		}
+	}
+}
+
+func BenchmarkSplittableRandom(b *testing.B) {
+	r := rng.NewSplittableRandom(uint64(b.N))
+	for i := 0; i < b.N; i++ {
+		r.Uint64()
+	}
+}
+
+func BenchmarkSplittableRandomSplit(b *testing.B) {
+	r := rng.NewSplittableRandom(uint64(b.N))
+	for i := 0; i < b.N; i++ {
+		r = r.Split()
+	}
+}
@@ -0,0 +1,168 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math/bits"
+	"math/rand"
+)
+
+// A Pcg32Stream is a Pcg32 with a selectable stream. The increment
+// picks one of 2^63 distinct sequences. It implements
+// math/rand.Source64 and may be seeded to any value, including its
+// zero value. Seeded through SeedStream, it reproduces pcg32_random_r
+// from the reference C library.
+type Pcg32Stream struct{ State, Inc uint64 }
+
+var _ rand.Source64 = (*Pcg32Stream)(nil)
+
+// NewPcg32Stream returns a Pcg32Stream seeded like pcg32_srandom_r.
+func NewPcg32Stream(initstate, initseq uint64) *Pcg32Stream {
+	s := new(Pcg32Stream)
+	s.SeedStream(initstate, initseq)
+	return s
+}
+
+// SeedStream seeds the generator exactly like pcg32_srandom_r,
+// selecting the stream with initseq.
+func (s *Pcg32Stream) SeedStream(initstate, initseq uint64) {
+	s.State = 0
+	s.Inc = initseq<<1 | 1
+	s.Uint32()
+	s.State += initstate
+	s.Uint32()
+}
+
+// Seed seeds the state without changing the stream.
+func (s *Pcg32Stream) Seed(seed int64) {
+	s.SeedStream(uint64(seed), s.Inc>>1)
+}
+
+// Uint32 returns a uniformly random 32-bit integer.
+func (s *Pcg32Stream) Uint32() uint32 {
+	p := s.State
+	s.State = p*pcg32M + (s.Inc | 1)
+	x := uint32((p>>18 ^ p) >> 27)
+	r := int(p >> 59)
+	return bits.RotateLeft32(x, -r)
+}
+
+func (s *Pcg32Stream) Uint64() uint64 {
+	lo := uint64(s.Uint32())
+	hi := uint64(s.Uint32())
+	return hi<<32 | lo
+}
+
+func (s *Pcg32Stream) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+// A Pcg64Stream is a 128-bit permuted congruential generator with a
+// selectable stream. The increment picks one of 2^127 distinct
+// sequences. It implements math/rand.Source64 and may be seeded to any
+// value, including its zero value. Unlike Pcg64, it uses the reference
+// XSL-RR output permutation so that, seeded through SeedStream, it
+// reproduces pcg64_random_r from the reference C library.
+type Pcg64Stream struct{ Hi, Lo, IncHi, IncLo uint64 }
+
+var _ rand.Source64 = (*Pcg64Stream)(nil)
+
+// NewPcg64Stream returns a Pcg64Stream seeded like pcg64_srandom_r
+// with the 128-bit initstate and initseq.
+func NewPcg64Stream(statehi, statelo, seqhi, seqlo uint64) *Pcg64Stream {
+	s := new(Pcg64Stream)
+	s.SeedStream(statehi, statelo, seqhi, seqlo)
+	return s
+}
+
+// SeedStream seeds the generator exactly like pcg64_srandom_r with the
+// 128-bit initstate and initseq, selecting the stream with initseq.
+func (s *Pcg64Stream) SeedStream(statehi, statelo, seqhi, seqlo uint64) {
+	s.Hi, s.Lo = 0, 0
+	s.IncHi = seqhi<<1 | seqlo>>63
+	s.IncLo = seqlo<<1 | 1
+	s.step()
+	var c uint64
+	s.Lo, c = bits.Add64(s.Lo, statelo, 0)
+	s.Hi += statehi + c
+	s.step()
+}
+
+// Seed seeds the state without changing the stream.
+func (s *Pcg64Stream) Seed(seed int64) {
+	seqhi, seqlo := s.IncHi>>1, s.IncLo>>1|s.IncHi<<63
+	s.SeedStream(0, uint64(seed), seqhi, seqlo)
+}
+
+func (s *Pcg64Stream) step() {
+	const (
+		mhi = pcg64Mhi
+		mlo = pcg64Mlo
+	)
+	carry, lo := bits.Mul64(mlo, s.Lo)
+	hi := mhi*s.Lo + s.Hi*mlo + carry
+	lo, carry = bits.Add64(lo, s.IncLo|1, 0)
+	hi += s.IncHi + carry
+	s.Lo = lo
+	s.Hi = hi
+}
+
+func (s *Pcg64Stream) Uint64() uint64 {
+	s.step()
+	return bits.RotateLeft64(s.Hi^s.Lo, -int(s.Hi>>58))
+}
+
+func (s *Pcg64Stream) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+// A Pcg64Dxsm is a 128-bit permuted congruential generator with a
+// selectable stream, using a 64-bit "cheap" multiplier and the DXSM
+// (double xorshift multiply) output permutation applied to the state
+// before each step. It matches NumPy's PCG64DXSM and implements
+// math/rand.Source64. May be seeded to any value.
+type Pcg64Dxsm struct{ Hi, Lo, IncHi, IncLo uint64 }
+
+var _ rand.Source64 = (*Pcg64Dxsm)(nil)
+
+const pcg64CheapM = 0xda942042e4dd58b5
+
+// NewPcg64Dxsm returns a Pcg64Dxsm seeded with the 128-bit initstate
+// and initseq.
+func NewPcg64Dxsm(statehi, statelo, seqhi, seqlo uint64) *Pcg64Dxsm {
+	s := new(Pcg64Dxsm)
+	s.SeedStream(statehi, statelo, seqhi, seqlo)
+	return s
+}
+
+// SeedStream seeds the generator with the 128-bit initstate and
+// initseq, selecting the stream with initseq. Like NumPy, seeding uses
+// the full 128-bit multiplier exactly as pcg64_srandom_r does.
+func (s *Pcg64Dxsm) SeedStream(statehi, statelo, seqhi, seqlo uint64) {
+	var p Pcg64Stream
+	p.SeedStream(statehi, statelo, seqhi, seqlo)
+	*s = Pcg64Dxsm(p)
+}
+
+// Seed seeds the state without changing the stream.
+func (s *Pcg64Dxsm) Seed(seed int64) {
+	seqhi, seqlo := s.IncHi>>1, s.IncLo>>1|s.IncHi<<63
+	s.SeedStream(0, uint64(seed), seqhi, seqlo)
+}
+
+func (s *Pcg64Dxsm) Uint64() uint64 {
+	hi, lo := s.Hi, s.Lo|1
+	carry, slo := bits.Mul64(s.Lo, pcg64CheapM)
+	shi := s.Hi*pcg64CheapM + carry
+	slo, carry = bits.Add64(slo, s.IncLo|1, 0)
+	s.Hi = shi + s.IncHi + carry
+	s.Lo = slo
+	hi ^= hi >> 32
+	hi *= pcg64CheapM
+	hi ^= hi >> 48
+	return hi * lo
+}
+
+func (s *Pcg64Dxsm) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
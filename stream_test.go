@@ -0,0 +1,89 @@
+package rng_test
+
+import (
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestPcg32Stream(t *testing.T) {
+	// Output from official pcg32-demo, seeded with 42, 54
+	want := []uint32{
+		0xa15c02b7, 0x7b47f409, 0xba1d3330, 0x83d2f293, 0xbfa4784b,
+		0xcbed606e,
+	}
+	r := rng.NewPcg32Stream(42, 54)
+	for i, w := range want {
+		got := r.Uint32()
+		if got != w {
+			t.Errorf("Pcg32Stream.Uint32(%d), got %#08x, want %#08x",
+				i, got, w)
+		}
+	}
+
+	// The hardcoded Pcg32 increment is just one particular stream.
+	p := rng.Pcg32(0)
+	s := rng.Pcg32Stream{State: 0, Inc: 0x14057b7ef767814f}
+	for i := 0; i < 8; i++ {
+		got, want := s.Uint32(), p.Uint32()
+		if got != want {
+			t.Errorf("Pcg32Stream.Uint32(%d), got %#08x, want %#08x",
+				i, got, want)
+		}
+	}
+}
+
+func TestPcg64Stream(t *testing.T) {
+	// Output from official pcg64-demo, seeded with 42, 54
+	want := []uint64{
+		0x86b1da1d72062b68, 0x1304aa46c9853d39, 0xa3670e9e0dd50358,
+		0xf9090e529a7dae00, 0xc85b9fd837996f2c, 0x606121f8e3919196,
+	}
+	r := rng.NewPcg64Stream(0, 42, 0, 54)
+	for i, w := range want {
+		got := r.Uint64()
+		if got != w {
+			t.Errorf("Pcg64Stream.Uint64(%d), got %#016x, want %#016x",
+				i, got, w)
+		}
+	}
+}
+
+func TestStreamsDiffer(t *testing.T) {
+	a := rng.NewPcg64Stream(0, 1, 0, 1)
+	b := rng.NewPcg64Stream(0, 1, 0, 2)
+	b.Seed(1)
+	if a.IncLo == b.IncLo {
+		t.Fatalf("Pcg64Stream.Seed() changed the stream")
+	}
+	same := 0
+	for i := 0; i < 64; i++ {
+		if a.Uint64() == b.Uint64() {
+			same++
+		}
+	}
+	if same > 1 {
+		t.Errorf("Pcg64Stream streams 1 and 2 share %d outputs", same)
+	}
+}
+
+func BenchmarkPcg32Stream(b *testing.B) {
+	r := rng.NewPcg32Stream(uint64(b.N), 1)
+	for i := 0; i < b.N; i++ {
+		r.Uint64()
+	}
+}
+
+func BenchmarkPcg64Stream(b *testing.B) {
+	r := rng.NewPcg64Stream(0, uint64(b.N), 0, 1)
+	for i := 0; i < b.N; i++ {
+		r.Uint64()
+	}
+}
+
+func BenchmarkPcg64Dxsm(b *testing.B) {
+	r := rng.NewPcg64Dxsm(0, uint64(b.N), 0, 1)
+	for i := 0; i < b.N; i++ {
+		r.Uint64()
+	}
+}
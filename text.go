@@ -0,0 +1,221 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The text state format is the generator name, a colon, then each
+// state word as 16 hexadecimal digits, most significant first:
+//
+//	xoshiro256ss:00000000000000010000000000000002...
+//
+// Since encoding/json uses encoding.TextMarshaler, generators also
+// serialize to and from JSON strings in this format.
+
+var errText = errors.New("rng: malformed state text")
+
+func marshalText(m encoding.BinaryMarshaler) ([]byte, error) {
+	b, err := m.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	name := b[2 : 2+int(b[1])]
+	words := b[2+len(name):]
+	var t strings.Builder
+	t.Write(name)
+	t.WriteByte(':')
+	for i := 0; i < len(words); i += 8 {
+		fmt.Fprintf(&t, "%016x", binary.LittleEndian.Uint64(words[i:]))
+	}
+	return []byte(t.String()), nil
+}
+
+func unmarshalText(u encoding.BinaryUnmarshaler, t []byte) error {
+	s := string(t)
+	colon := strings.IndexByte(s, ':')
+	if colon < 0 || colon > 255 || (len(s)-colon-1)%16 != 0 {
+		return errText
+	}
+	name, hex := s[:colon], s[colon+1:]
+	b := make([]byte, 2+len(name), 2+len(name)+len(hex)/2)
+	b[0] = marshalVersion
+	b[1] = byte(len(name))
+	copy(b[2:], name)
+	for i := 0; i < len(hex); i += 16 {
+		w, err := strconv.ParseUint(hex[i:i+16], 16, 64)
+		if err != nil {
+			return errText
+		}
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], w)
+		b = append(b, buf[:]...)
+	}
+	return u.UnmarshalBinary(b)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Lcg128) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Lcg128) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SplitMix64) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SplitMix64) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SplittableRandom) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SplittableRandom) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Xoshiro256ss) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Xoshiro256ss) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Pcg32) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Pcg32) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Pcg32Stream) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Pcg32Stream) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Pcg64) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Pcg64) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Pcg64Stream) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Pcg64Stream) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Pcg64Dxsm) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Pcg64Dxsm) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Pcg64x) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Pcg64x) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Msws64) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Msws64) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RomuDuo) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RomuDuo) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RomuDuoJr) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RomuDuoJr) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Mmlfg) MarshalText() ([]byte, error) {
+	return marshalText(m)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mmlfg) UnmarshalText(t []byte) error {
+	return unmarshalText(m, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (m Mwc256xxa64) MarshalText() ([]byte, error) {
+	return marshalText(m)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *Mwc256xxa64) UnmarshalText(t []byte) error {
+	return unmarshalText(m, t)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Sfc64) MarshalText() ([]byte, error) {
+	return marshalText(s)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Sfc64) UnmarshalText(t []byte) error {
+	return unmarshalText(s, t)
+}
@@ -0,0 +1,122 @@
+package rng_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestMarshalText(t *testing.T) {
+	x := rng.NewXoshiro256ss(1, 2, 3, 0x0123456789abcdef)
+	got, err := x.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "xoshiro256ss:" +
+		"0000000000000001" + "0000000000000002" +
+		"0000000000000003" + "0123456789abcdef"
+	if string(got) != want {
+		t.Errorf("Xoshiro256ss.MarshalText(), got %q, want %q", got, want)
+	}
+
+	type textMarshaler interface {
+		rand.Source64
+		encoding.TextMarshaler
+		encoding.TextUnmarshaler
+	}
+	for name, gen := range generators() {
+		a, ok := gen().(textMarshaler)
+		if !ok {
+			t.Errorf("%s does not implement text marshaling", name)
+			continue
+		}
+		a.Uint64()
+		text, err := a.MarshalText()
+		if err != nil {
+			t.Fatalf("%s.MarshalText(), %v", name, err)
+		}
+		b := gen().(textMarshaler)
+		err = b.UnmarshalText(text)
+		if err != nil {
+			t.Fatalf("%s.UnmarshalText(%q), %v", name, text, err)
+		}
+		if got, want := b.Uint64(), a.Uint64(); got != want {
+			t.Errorf("%s resumed from %q, got %#016x, want %#016x",
+				name, text, got, want)
+		}
+	}
+}
+
+func TestUnmarshalTextInvalid(t *testing.T) {
+	var x rng.Xoshiro256ss
+	table := []string{
+		"",
+		"xoshiro256ss",
+		"xoshiro256ss:0123",
+		"xoshiro256ss:000000000000000g000000000000000000000000000000000000000000000000",
+		"sfc64:0000000000000001000000000000000200000000000000030000000000000004",
+		"xoshiro256ss:0000000000000000000000000000000000000000000000000000000000000000",
+	}
+	for _, text := range table {
+		if err := x.UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("Xoshiro256ss.UnmarshalText(%q) succeeded", text)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type config struct {
+		Rng *rng.Sfc64 `json:"rng"`
+	}
+	a := config{rng.NewSfc64(1, 2, 3)}
+	buf, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b config
+	if err := json.Unmarshal(buf, &b); err != nil {
+		t.Fatalf("json.Unmarshal(%s), %v", buf, err)
+	}
+	if *a.Rng != *b.Rng {
+		t.Errorf("JSON round trip via %s, got %#x, want %#x",
+			buf, *b.Rng, *a.Rng)
+	}
+
+	var c struct {
+		Rng *rng.Pcg64 `json:"rng"`
+	}
+	if err := json.Unmarshal(buf, &c); err == nil {
+		t.Errorf("json.Unmarshal(%s) into Pcg64 succeeded", buf)
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	// Generators held by value keep their state through JSON too.
+	type config struct {
+		Romu  rng.RomuDuo
+		Lfg   rng.Mmlfg
+		Sfc   rng.Sfc64
+		Split rng.SplitMix64
+	}
+	a := config{
+		*rng.NewRomuDuo(1, 2),
+		*rng.NewMmlfg([15]uint64{3, 4, 5}),
+		*rng.NewSfc64(6, 7, 8),
+		*rng.NewSplitMix64(9),
+	}
+	a.Lfg.Uint64()
+	buf, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b config
+	if err := json.Unmarshal(buf, &b); err != nil {
+		t.Fatalf("json.Unmarshal(%s), %v", buf, err)
+	}
+	if a != b {
+		t.Errorf("JSON round trip via %s, got %+v, want %+v", buf, b, a)
+	}
+}
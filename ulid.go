@@ -0,0 +1,136 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// A ULID is a Universally Unique Lexicographically Sortable
+// Identifier: a 48-bit Unix millisecond timestamp followed by 80
+// random bits, written as 26 characters of Crockford's base 32 that
+// sort in the same order as the bytes.
+type ULID [16]byte
+
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// A ULIDGenerator produces monotonic ULIDs: within one millisecond,
+// each ULID's random part is the previous one plus one, as in the ULID
+// specification. Rather than fail on the (astronomically unlikely)
+// overflow of the random part, it advances the timestamp by a
+// millisecond. A ULIDGenerator is not safe for concurrent use.
+type ULIDGenerator struct {
+	// Now returns the current time. It defaults to time.Now and may be
+	// replaced, for example with a fixed clock in tests.
+	Now func() time.Time
+
+	src rand.Source64
+	ms  int64
+	hi  uint16 // top 16 random bits
+	lo  uint64 // bottom 64 random bits
+}
+
+// NewULIDGenerator returns a generator drawing random bits from src.
+func NewULIDGenerator(src rand.Source64) *ULIDGenerator {
+	return &ULIDGenerator{Now: time.Now, src: src, ms: -1}
+}
+
+// New returns the next ULID, which sorts after every ULID previously
+// returned by g.
+func (g *ULIDGenerator) New() ULID {
+	ms := g.Now().UnixMilli()
+	switch {
+	case ms > g.ms:
+		g.ms = ms
+		g.reseed()
+	case g.lo+1 != 0:
+		g.lo++
+	case g.hi+1 != 0:
+		g.lo = 0
+		g.hi++
+	default:
+		g.ms++
+		g.reseed()
+	}
+
+	var u ULID
+	binary.BigEndian.PutUint64(u[:8], uint64(g.ms)<<16|uint64(g.hi))
+	binary.BigEndian.PutUint64(u[8:], g.lo)
+	return u
+}
+
+func (g *ULIDGenerator) reseed() {
+	g.hi = uint16(g.src.Uint64() >> 48)
+	g.lo = g.src.Uint64()
+}
+
+// Time returns the ULID's timestamp.
+func (u ULID) Time() time.Time {
+	ms := binary.BigEndian.Uint64(u[:8]) >> 16
+	return time.UnixMilli(int64(ms))
+}
+
+// String returns the canonical 26-character form, such as
+// "01J2R8Q3ZK7YV5N0C4W6X9T1BD".
+func (u ULID) String() string {
+	b, _ := u.MarshalText()
+	return string(b)
+}
+
+// MarshalText implements encoding.TextMarshaler using the canonical
+// form.
+func (u ULID) MarshalText() ([]byte, error) {
+	// 128 bits are 26 digits of 5 bits, with 2 spare bits at the top.
+	hi := binary.BigEndian.Uint64(u[:8])
+	lo := binary.BigEndian.Uint64(u[8:])
+	b := make([]byte, 26)
+	for i := 25; i >= 0; i-- {
+		b[i] = crockford[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return b, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the
+// canonical form in either case.
+func (u *ULID) UnmarshalText(b []byte) error {
+	if len(b) != 26 || b[0] > '7' {
+		return fmt.Errorf("rng: invalid ULID %q", b)
+	}
+	var hi, lo uint64
+	for _, c := range b {
+		d := crockfordDigit(c)
+		if d < 0 {
+			return fmt.Errorf("rng: invalid ULID %q", b)
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(d)
+	}
+	binary.BigEndian.PutUint64(u[:8], hi)
+	binary.BigEndian.PutUint64(u[8:], lo)
+	return nil
+}
+
+// crockfordDigit returns the value of a base 32 digit, or -1.
+func crockfordDigit(c byte) int {
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	for i := 0; i < len(crockford); i++ {
+		if crockford[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseULID parses a ULID in canonical form.
+func ParseULID(s string) (ULID, error) {
+	var u ULID
+	err := u.UnmarshalText([]byte(s))
+	return u, err
+}
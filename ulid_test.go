@@ -0,0 +1,85 @@
+package rng_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestULID(t *testing.T) {
+	// Example from the ULID specification.
+	u, err := rng.ParseULID("01ARYZ6S41TSV4RRFFQ69G5FAV")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms := u.Time().UnixMilli(); ms != 1469918176385 {
+		t.Errorf("Time(), got %d, want 1469918176385", ms)
+	}
+	if s := u.String(); s != "01ARYZ6S41TSV4RRFFQ69G5FAV" {
+		t.Errorf("String(), got %s", s)
+	}
+	if v, err := rng.ParseULID("01aryz6s41tsv4rrffq69g5fav"); err != nil || v != u {
+		t.Errorf("ParseULID(lowercase), got %v, %v", v, err)
+	}
+
+	max := "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"
+	if v, err := rng.ParseULID(max); err != nil || v.String() != max {
+		t.Errorf("ParseULID(%s), got %v, %v", max, v, err)
+	}
+	for _, bad := range []string{
+		"",
+		"01ARYZ6S41TSV4RRFFQ69G5FA",
+		"80000000000000000000000000",
+		"01ARYZ6S41TSV4RRFFQ69G5FAU",
+		"01ARYZ6S41TSV4RRFFQ69G5FAI",
+		"01ARYZ6S41TSV4RRFFQ69G5FA-",
+	} {
+		if _, err := rng.ParseULID(bad); err == nil {
+			t.Errorf("ParseULID(%q) accepted", bad)
+		}
+	}
+}
+
+func TestULIDGenerator(t *testing.T) {
+	now := time.UnixMilli(1469918176385)
+	g := rng.NewULIDGenerator(rng.NewSfc64(1, 2, 3))
+	g.Now = func() time.Time { return now }
+
+	first := g.New()
+	if !first.Time().Equal(now) {
+		t.Errorf("Time(), got %v, want %v", first.Time(), now)
+	}
+	if s := first.String(); !strings.HasPrefix(s, "01ARYZ6S41") {
+		t.Errorf("String(), got %s", s)
+	}
+
+	// Within a millisecond, the random part counts up by one.
+	prev := first
+	for i := 1; i < 1000; i++ {
+		u := g.New()
+		if u.String() <= prev.String() {
+			t.Fatalf("New(), got %v after %v", u, prev)
+		}
+		if u[15]-prev[15] != 1 {
+			t.Fatalf("New(), got %v after %v", u, prev)
+		}
+		if v, err := rng.ParseULID(u.String()); err != nil || v != u {
+			t.Fatalf("ParseULID(%s), got %v, %v", u, v, err)
+		}
+		prev = u
+	}
+
+	now = now.Add(time.Millisecond)
+	if u := g.New(); u.String() <= prev.String() || !u.Time().Equal(now) {
+		t.Errorf("New(), got %v after %v", u, prev)
+	}
+}
+
+func BenchmarkULID(b *testing.B) {
+	g := rng.NewULIDGenerator(rng.NewXoshiro256ss(1, 2, 3, 4))
+	for i := 0; i < b.N; i++ {
+		g.New()
+	}
+}
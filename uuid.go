@@ -0,0 +1,134 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// A UUID is a universally unique identifier as defined by RFC 9562.
+// UUIDs made from a pseudo-random generator are only as unpredictable
+// as its seed, so they suit tests and simulations but not tokens that
+// must resist guessing.
+type UUID [16]byte
+
+// NewUUIDv4 returns a random (version 4) UUID from two outputs of src.
+// Aside from the version and variant bits, its bytes are the first 16
+// bytes a Reader over src would produce.
+func NewUUIDv4(src rand.Source64) UUID {
+	var u UUID
+	binary.LittleEndian.PutUint64(u[:8], src.Uint64())
+	binary.LittleEndian.PutUint64(u[8:], src.Uint64())
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return u
+}
+
+// A UUIDv7Generator produces time-ordered (version 7) UUIDs: a 48-bit
+// Unix millisecond timestamp, a 26-bit counter, and 48 random bits.
+// The counter starts at a random value below 2^25 each millisecond and
+// increments within it (RFC 9562, section 6.2, method 1), so UUIDs
+// from one generator sort in creation order even if the clock stalls
+// or steps backwards. Should the counter overflow, the timestamp
+// advances by a millisecond. A UUIDv7Generator is not safe for
+// concurrent use.
+type UUIDv7Generator struct {
+	// Now returns the current time. It defaults to time.Now and may be
+	// replaced, for example with a fixed clock in tests.
+	Now func() time.Time
+
+	src     rand.Source64
+	ms      int64
+	counter uint32
+}
+
+// NewUUIDv7Generator returns a generator drawing random bits from src.
+func NewUUIDv7Generator(src rand.Source64) *UUIDv7Generator {
+	return &UUIDv7Generator{Now: time.Now, src: src, ms: -1}
+}
+
+// New returns the next UUID, which sorts after every UUID previously
+// returned by g.
+func (g *UUIDv7Generator) New() UUID {
+	ms := g.Now().UnixMilli()
+	switch {
+	case ms > g.ms:
+		g.ms = ms
+		g.counter = uint32(g.src.Uint64() >> 39)
+	case g.counter+1 < 1<<26:
+		g.counter++
+	default:
+		g.ms++
+		g.counter = uint32(g.src.Uint64() >> 39)
+	}
+
+	var u UUID
+	r := g.src.Uint64()
+	binary.BigEndian.PutUint64(u[:8], uint64(g.ms)<<16)
+	binary.LittleEndian.PutUint64(u[8:], r<<16)
+	c := g.counter
+	u[6] = 0x70 | byte(c>>22)
+	u[7] = byte(c >> 14)
+	u[8] = 0x80 | byte(c>>8)&0x3f
+	u[9] = byte(c)
+	return u
+}
+
+// Version returns the UUID's version number from its top four bits of
+// byte 6, such as 4 or 7.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// String returns the canonical form, such as
+// "0190b0a1-55d2-7c41-8a3e-2f6a9d1e4b7c", in lowercase.
+func (u UUID) String() string {
+	b, _ := u.MarshalText()
+	return string(b)
+}
+
+// MarshalText implements encoding.TextMarshaler using the canonical
+// form.
+func (u UUID) MarshalText() ([]byte, error) {
+	b := make([]byte, 36)
+	hex.Encode(b[0:8], u[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], u[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], u[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], u[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:36], u[10:16])
+	return b, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the
+// canonical form in either case.
+func (u *UUID) UnmarshalText(b []byte) error {
+	var v UUID
+	if len(b) != 36 || b[8] != '-' || b[13] != '-' || b[18] != '-' || b[23] != '-' {
+		return fmt.Errorf("rng: invalid UUID %q", b)
+	}
+	j := 0
+	for _, g := range [5][2]int{{0, 8}, {9, 13}, {14, 18}, {19, 23}, {24, 36}} {
+		n, err := hex.Decode(v[j:], b[g[0]:g[1]])
+		if err != nil {
+			return fmt.Errorf("rng: invalid UUID %q", b)
+		}
+		j += n
+	}
+	*u = v
+	return nil
+}
+
+// ParseUUID parses a UUID in canonical form.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	err := u.UnmarshalText([]byte(s))
+	return u, err
+}
@@ -0,0 +1,122 @@
+package rng_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestUUIDv4(t *testing.T) {
+	r := rng.NewSfc64(1, 2, 3)
+	var raw [16]byte
+	io.ReadFull(rng.NewReader(rng.NewSfc64(1, 2, 3)), raw[:])
+	u := rng.NewUUIDv4(r)
+	if u.Version() != 4 || u[8]>>6 != 2 {
+		t.Errorf("NewUUIDv4(), got version %d variant %b",
+			u.Version(), u[8]>>6)
+	}
+	raw[6] = raw[6]&0x0f | 0x40
+	raw[8] = raw[8]&0x3f | 0x80
+	if u != rng.UUID(raw) {
+		t.Errorf("NewUUIDv4(), got %v, want %v", u, rng.UUID(raw))
+	}
+
+	seen := make(map[rng.UUID]bool)
+	for i := 0; i < 10000; i++ {
+		u := rng.NewUUIDv4(r)
+		if seen[u] {
+			t.Fatalf("NewUUIDv4(), got duplicate %v", u)
+		}
+		seen[u] = true
+	}
+}
+
+func TestUUIDText(t *testing.T) {
+	const s = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	u, err := rng.ParseUUID(strings.ToUpper(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.String() != s {
+		t.Errorf("String(), got %s, want %s", u, s)
+	}
+	if u.Version() != 1 {
+		t.Errorf("Version(), got %d, want 1", u.Version())
+	}
+
+	buf, err := json.Marshal(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v rng.UUID
+	if err := json.Unmarshal(buf, &v); err != nil || v != u {
+		t.Errorf("JSON round trip, got %v, %v", v, err)
+	}
+
+	for _, bad := range []string{
+		"",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8a",
+		"6ba7b8109dad-11d1-80b4-00c04fd430c8",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430cg",
+		"6ba7b810+9dad-11d1-80b4-00c04fd430c8",
+	} {
+		if _, err := rng.ParseUUID(bad); err == nil {
+			t.Errorf("ParseUUID(%q) accepted", bad)
+		}
+	}
+}
+
+func TestUUIDv7(t *testing.T) {
+	now := time.UnixMilli(1700000000123)
+	g := rng.NewUUIDv7Generator(rng.NewXoshiro256ss(1, 2, 3, 4))
+	g.Now = func() time.Time { return now }
+
+	var prev rng.UUID
+	for i := 0; i < 10000; i++ {
+		if i == 5000 {
+			// A clock stepping backwards does not break ordering.
+			now = now.Add(-time.Second)
+		}
+		u := g.New()
+		if u.Version() != 7 || u[8]>>6 != 2 {
+			t.Fatalf("New(), got version %d variant %b",
+				u.Version(), u[8]>>6)
+		}
+		if i > 0 && bytes.Compare(prev[:], u[:]) >= 0 {
+			t.Fatalf("New(), got %v after %v", u, prev)
+		}
+		prev = u
+	}
+	ms := int64(prev[0])<<40 | int64(prev[1])<<32 | int64(prev[2])<<24 |
+		int64(prev[3])<<16 | int64(prev[4])<<8 | int64(prev[5])
+	if want := int64(1700000000123); ms != want {
+		t.Errorf("New() timestamp, got %d, want %d", ms, want)
+	}
+
+	// A new millisecond starts a new counter.
+	now = time.UnixMilli(1700000000124)
+	u := g.New()
+	if bytes.Compare(prev[:], u[:]) >= 0 || u[5] != prev[5]+1 {
+		t.Errorf("New(), got %v after %v", u, prev)
+	}
+}
+
+func BenchmarkUUIDv4(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for i := 0; i < b.N; i++ {
+		rng.NewUUIDv4(r)
+	}
+}
+
+func BenchmarkUUIDv7(b *testing.B) {
+	g := rng.NewUUIDv7Generator(rng.NewXoshiro256ss(1, 2, 3, 4))
+	for i := 0; i < b.N; i++ {
+		g.New()
+	}
+}
@@ -0,0 +1,192 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math"
+)
+
+// A Keyed is an item held by a WeightedReservoir along with its
+// sampling key. Keys are the logarithms of the keys u^(1/w) of
+// Efraimidis and Spirakis, which keeps them distinct and finite for
+// weights that would otherwise underflow.
+type Keyed[T any] struct {
+	Item T
+	Key  float64
+}
+
+// A WeightedReservoir holds a weighted random sample of up to k items,
+// without replacement, from a stream of unknown length: the sample is
+// distributed as if items were drawn one at a time, each with
+// probability proportional to its weight among those remaining. It
+// follows Efraimidis and Spirakis, "Weighted random sampling with a
+// reservoir" (2006), giving each item a random key and keeping the k
+// largest.
+//
+// Because the sample is just the k largest keys, reservoirs filled
+// from disjoint parts of a stream, such as shards, combine exactly by
+// merging their entries. Each shard should use an independent
+// generator.
+type WeightedReservoir[T any, G Generator] struct {
+	g     G
+	k     int
+	heap  []Keyed[T] // min-heap on Key
+	expj  bool
+	jump  float64 // weight left to skip, with expj
+	total float64
+}
+
+// NewWeightedReservoir returns an empty reservoir of capacity k that
+// draws a key for every item (algorithm A-Res). It panics if k < 1.
+func NewWeightedReservoir[T any, G Generator](g G, k int) *WeightedReservoir[T, G] {
+	if k < 1 {
+		panic("invalid argument to NewWeightedReservoir")
+	}
+	return &WeightedReservoir[T, G]{g: g, k: k, heap: make([]Keyed[T], 0, k)}
+}
+
+// NewWeightedReservoirExpJ returns an empty reservoir of capacity k
+// that, once full, draws exponential jumps over the total weight to
+// skip (algorithm A-ExpJ). It samples from the same distribution as
+// A-Res but consults the generator only O(k log(n/k)) times over n
+// items. It panics if k < 1.
+func NewWeightedReservoirExpJ[T any, G Generator](g G, k int) *WeightedReservoir[T, G] {
+	r := NewWeightedReservoir[T](g, k)
+	r.expj = true
+	return r
+}
+
+// Add offers the next item of the stream with the given weight. Items
+// with zero weight are never sampled. It panics if weight is negative,
+// infinite, or NaN.
+func (r *WeightedReservoir[T, G]) Add(item T, weight float64) {
+	if !(weight >= 0) || math.IsInf(weight, 1) {
+		panic("invalid weight in WeightedReservoir.Add")
+	}
+	r.total += weight
+	if weight == 0 {
+		return
+	}
+
+	if len(r.heap) < r.k {
+		r.push(Keyed[T]{item, math.Log(OpenFloat64(r.g)) / weight})
+		if r.expj && len(r.heap) == r.k {
+			r.newJump()
+		}
+		return
+	}
+
+	if !r.expj {
+		key := math.Log(OpenFloat64(r.g)) / weight
+		if key > r.heap[0].Key {
+			r.replaceMin(Keyed[T]{item, key})
+		}
+		return
+	}
+
+	if r.jump > weight {
+		r.jump -= weight
+		return
+	}
+	// This item's key exceeds the threshold t, so draw it from the key
+	// distribution conditioned on that: u uniform in (e^(t*w), 1).
+	lo := math.Exp(r.heap[0].Key * weight)
+	u := lo + (1-lo)*OpenFloat64(r.g)
+	r.replaceMin(Keyed[T]{item, math.Log(u) / weight})
+	r.newJump()
+}
+
+// AddAll offers every item and weight yielded by seq, which has the
+// shape of an iter.Seq2[T, float64].
+func (r *WeightedReservoir[T, G]) AddAll(seq func(yield func(T, float64) bool)) {
+	seq(func(item T, weight float64) bool {
+		r.Add(item, weight)
+		return true
+	})
+}
+
+// newJump draws the total weight to skip before the next item whose
+// key exceeds the current threshold.
+func (r *WeightedReservoir[T, G]) newJump() {
+	r.jump = math.Log(OpenFloat64(r.g)) / r.heap[0].Key
+}
+
+// Merge combines the entries and total weight of another reservoir,
+// such as one filled from a different shard of the same stream, into
+// this one. The result is a sample of the combined stream, and
+// TotalWeight afterwards includes the merged shard's total. It panics
+// if total is negative, infinite, or NaN.
+func (r *WeightedReservoir[T, G]) Merge(entries []Keyed[T], total float64) {
+	if !(total >= 0) || math.IsInf(total, 1) {
+		panic("invalid total in WeightedReservoir.Merge")
+	}
+	r.total += total
+	for _, e := range entries {
+		switch {
+		case len(r.heap) < r.k:
+			r.push(e)
+		case e.Key > r.heap[0].Key:
+			r.replaceMin(e)
+		}
+	}
+	if r.expj && len(r.heap) == r.k {
+		// Skipped weight follows a memoryless distribution, so a new
+		// jump from the raised threshold is as good as the old one.
+		r.newJump()
+	}
+}
+
+// Entries returns the current sample with keys, in no particular
+// order, for passing to Merge along with TotalWeight. The slice is
+// owned by the reservoir and changes with later calls to Add and
+// Merge.
+func (r *WeightedReservoir[T, G]) Entries() []Keyed[T] {
+	return r.heap
+}
+
+// Items returns a copy of the current sample, in no particular order.
+func (r *WeightedReservoir[T, G]) Items() []T {
+	items := make([]T, len(r.heap))
+	for i, e := range r.heap {
+		items[i] = e.Item
+	}
+	return items
+}
+
+// TotalWeight returns the sum of the weights offered to Add and the
+// totals passed to Merge.
+func (r *WeightedReservoir[T, G]) TotalWeight() float64 {
+	return r.total
+}
+
+func (r *WeightedReservoir[T, G]) push(e Keyed[T]) {
+	h := append(r.heap, e)
+	for i := len(h) - 1; i > 0; {
+		p := (i - 1) / 2
+		if h[p].Key <= h[i].Key {
+			break
+		}
+		h[p], h[i] = h[i], h[p]
+		i = p
+	}
+	r.heap = h
+}
+
+func (r *WeightedReservoir[T, G]) replaceMin(e Keyed[T]) {
+	h := r.heap
+	h[0] = e
+	for i := 0; ; {
+		m := i
+		if c := 2*i + 1; c < len(h) && h[c].Key < h[m].Key {
+			m = c
+		}
+		if c := 2*i + 2; c < len(h) && h[c].Key < h[m].Key {
+			m = c
+		}
+		if m == i {
+			break
+		}
+		h[i], h[m] = h[m], h[i]
+		i = m
+	}
+}
@@ -0,0 +1,188 @@
+package rng_test
+
+import (
+	"math"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+// pairProbs returns the probability of each unordered pair {i, j},
+// i < j, under successive weighted sampling without replacement.
+func pairProbs(w []float64) map[[2]int]float64 {
+	var total float64
+	for _, x := range w {
+		total += x
+	}
+	p := make(map[[2]int]float64)
+	for i := range w {
+		for j := i + 1; j < len(w); j++ {
+			p[[2]int{i, j}] = w[i]/total*w[j]/(total-w[i]) +
+				w[j]/total*w[i]/(total-w[j])
+		}
+	}
+	return p
+}
+
+// checkPairs runs a chi-square test of sampled pairs against w.
+func checkPairs(t *testing.T, name string, w []float64, sample func() []int) {
+	t.Helper()
+	const trials = 100000
+	probs := pairProbs(w)
+	counts := make(map[[2]int]int)
+	for i := 0; i < trials; i++ {
+		s := sample()
+		if len(s) != 2 {
+			t.Fatalf("%s, got %d items, want 2", name, len(s))
+		}
+		if s[0] > s[1] {
+			s[0], s[1] = s[1], s[0]
+		}
+		counts[[2]int{s[0], s[1]}]++
+	}
+	var observed []int
+	var expected []float64
+	for pair, p := range probs {
+		if p == 0 {
+			if counts[pair] != 0 {
+				t.Errorf("%s, got zero-weight pair %v", name, pair)
+			}
+			continue
+		}
+		observed = append(observed, counts[pair])
+		expected = append(expected, p*trials)
+	}
+	df := len(expected) - 1
+	if x := chiSquare(observed, expected); !chiSquareOK(x, df) {
+		t.Errorf("%s chi-square, got %v with %d degrees of freedom",
+			name, x, df)
+	}
+}
+
+func TestWeightedReservoir(t *testing.T) {
+	weights := []float64{1, 0, 2, 3, 0.5, 4, 0.2}
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+
+	checkPairs(t, "A-Res", weights, func() []int {
+		res := rng.NewWeightedReservoir[int](r, 2)
+		for i, w := range weights {
+			res.Add(i, w)
+		}
+		return res.Items()
+	})
+
+	checkPairs(t, "A-ExpJ", weights, func() []int {
+		res := rng.NewWeightedReservoirExpJ[int](r, 2)
+		res.AddAll(func(yield func(int, float64) bool) {
+			for i, w := range weights {
+				if !yield(i, w) {
+					return
+				}
+			}
+		})
+		return res.Items()
+	})
+
+	// Shards with independent generators merge into a sample of the
+	// whole stream.
+	s1 := rng.NewSfc64(1, 2, 3)
+	s2 := rng.NewPcg64(4, 5)
+	s3 := rng.NewXoshiro256ss(6, 7, 8, 9)
+	s4 := rng.NewRomuDuo(10, 11)
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	checkPairs(t, "merged", weights, func() []int {
+		a := rng.NewWeightedReservoirExpJ[int](s1, 2)
+		b := rng.NewWeightedReservoir[int](s2, 2)
+		c := rng.NewWeightedReservoirExpJ[int](s3, 2)
+		for i, w := range weights {
+			switch i % 3 {
+			case 0:
+				a.Add(i, w)
+			case 1:
+				b.Add(i, w)
+			case 2:
+				c.Add(i, w)
+			}
+		}
+		m := rng.NewWeightedReservoirExpJ[int](s4, 2)
+		m.Merge(a.Entries(), a.TotalWeight())
+		m.Merge(b.Entries(), b.TotalWeight())
+		m.Merge(c.Entries(), c.TotalWeight())
+		if m.TotalWeight() != total {
+			t.Fatalf("merged TotalWeight(), got %v, want %v",
+				m.TotalWeight(), total)
+		}
+		return m.Items()
+	})
+}
+
+func TestWeightedReservoirLong(t *testing.T) {
+	// With k = 1 and a long stream, nearly every item is reached by an
+	// exponential jump, and each is chosen in proportion to its weight.
+	const n = 50
+	const trials = 100000
+	r := rng.NewRomuDuo(1, 2)
+	weights := make([]float64, n)
+	var total float64
+	for i := range weights {
+		weights[i] = float64(i%5 + 1)
+		total += weights[i]
+	}
+	counts := make([]int, n)
+	for i := 0; i < trials; i++ {
+		res := rng.NewWeightedReservoirExpJ[int](r, 1)
+		for j, w := range weights {
+			res.Add(j, w)
+		}
+		counts[res.Items()[0]]++
+		if res.TotalWeight() != total {
+			t.Fatalf("TotalWeight(), got %v, want %v",
+				res.TotalWeight(), total)
+		}
+	}
+	expected := make([]float64, n)
+	for i, w := range weights {
+		expected[i] = trials * w / total
+	}
+	if x := chiSquare(counts, expected); !chiSquareOK(x, n-1) {
+		t.Errorf("A-ExpJ chi-square, got %v", x)
+	}
+}
+
+func TestWeightedReservoirSmall(t *testing.T) {
+	r := rng.NewSfc64(1, 2, 3)
+	res := rng.NewWeightedReservoir[string](r, 3)
+	res.Add("a", 1)
+	res.Add("b", 0)
+	res.Add("c", 1e-300)
+	if got := len(res.Items()); got != 2 {
+		t.Errorf("Items(), got %d items, want 2", got)
+	}
+	for _, e := range res.Entries() {
+		if e.Item == "b" {
+			t.Errorf("Items(), got zero-weight item")
+		}
+		if math.IsInf(e.Key, 0) || math.IsNaN(e.Key) {
+			t.Errorf("Entries(), got key %v", e.Key)
+		}
+	}
+}
+
+func BenchmarkWeightedReservoir(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	res := rng.NewWeightedReservoir[int](r, 100)
+	for i := 0; i < b.N; i++ {
+		res.Add(i, float64(i%7+1))
+	}
+}
+
+func BenchmarkWeightedReservoirExpJ(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	res := rng.NewWeightedReservoirExpJ[int](r, 100)
+	for i := 0; i < b.N; i++ {
+		res.Add(i, float64(i%7+1))
+	}
+}
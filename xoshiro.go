@@ -0,0 +1,147 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"sync"
+)
+
+// xoshiroPoly holds the low 256 coefficients of the characteristic
+// polynomial of the xoshiro256 linear engine over GF(2). The x^256
+// term is implicit. Found with Berlekamp-Massey.
+var xoshiroPoly = [4]uint64{
+	0x9d116f2bb0f0f001, 0x0280002bcefd1a5e,
+	0x04b4edcf26259f85, 0x0003c03c3f3ecb19,
+}
+
+// polyMulMod returns a*b mod xoshiroPoly over GF(2).
+func polyMulMod(a, b [4]uint64) [4]uint64 {
+	var r [4]uint64
+	for i := 255; i >= 0; i-- {
+		// r = r*x mod p
+		top := r[3] >> 63
+		r[3] = r[3]<<1 | r[2]>>63
+		r[2] = r[2]<<1 | r[1]>>63
+		r[1] = r[1]<<1 | r[0]>>63
+		r[0] <<= 1
+		if top != 0 {
+			r[0] ^= xoshiroPoly[0]
+			r[1] ^= xoshiroPoly[1]
+			r[2] ^= xoshiroPoly[2]
+			r[3] ^= xoshiroPoly[3]
+		}
+		if a[i/64]>>uint(i%64)&1 != 0 {
+			r[0] ^= b[0]
+			r[1] ^= b[1]
+			r[2] ^= b[2]
+			r[3] ^= b[3]
+		}
+	}
+	return r
+}
+
+var (
+	xoshiroPow2Once sync.Once
+	xoshiroPow2     [256][4]uint64 // x^(2^k) mod p
+)
+
+// Xoshiro256ssJumpPoly returns the jump polynomial for advancing a
+// Xoshiro256ss by the 256-bit distance d, least significant word
+// first. The result may be passed to JumpPoly any number of times. The
+// polynomials for power-of-two distances are computed once and cached.
+func Xoshiro256ssJumpPoly(d [4]uint64) [4]uint64 {
+	xoshiroPow2Once.Do(func() {
+		p := [4]uint64{2} // x
+		for k := range xoshiroPow2 {
+			xoshiroPow2[k] = p
+			p = polyMulMod(p, p)
+		}
+	})
+	r := [4]uint64{1}
+	for k := 0; k < 256; k++ {
+		if d[k/64]>>uint(k%64)&1 != 0 {
+			r = polyMulMod(r, xoshiroPow2[k])
+		}
+	}
+	return r
+}
+
+// JumpPoly applies a jump polynomial, such as one returned by
+// Xoshiro256ssJumpPoly, to the generator.
+func (s *Xoshiro256ss) JumpPoly(poly [4]uint64) {
+	var s0, s1, s2, s3 uint64
+	for _, j := range poly {
+		for b := uint(0); b < 64; b++ {
+			if j&(1<<b) != 0 {
+				s0 ^= s[0]
+				s1 ^= s[1]
+				s2 ^= s[2]
+				s3 ^= s[3]
+			}
+			s.Uint64()
+		}
+	}
+	s[0] = s0
+	s[1] = s1
+	s[2] = s2
+	s[3] = s3
+}
+
+// Advance is equivalent to d calls to Uint64(), where d is a 256-bit
+// distance, least significant word first.
+func (s *Xoshiro256ss) Advance(d [4]uint64) {
+	s.JumpPoly(Xoshiro256ssJumpPoly(d))
+}
+
+// A xoshiroMatrix is a jump polynomial expanded into a 256x256 bit
+// matrix over GF(2), byte-sliced so that applying it takes 32 table
+// lookups: entry [i][v] is the image of a state that is zero except
+// for byte i, which has value v.
+type xoshiroMatrix [32][256][4]uint64
+
+func newXoshiroMatrix(poly [4]uint64) *xoshiroMatrix {
+	m := new(xoshiroMatrix)
+	for i := 0; i < 32; i++ {
+		for b := uint(0); b < 8; b++ {
+			var s Xoshiro256ss
+			s[i/8] = 1 << (uint(i%8)*8 + b)
+			s.JumpPoly(poly)
+			m[i][1<<b] = s
+		}
+		// Remaining entries are sums of the single-bit entries.
+		for v := 3; v < 256; v++ {
+			lo := v & -v
+			if v != lo {
+				r := &m[i][v]
+				a, b := &m[i][v^lo], &m[i][lo]
+				r[0] = a[0] ^ b[0]
+				r[1] = a[1] ^ b[1]
+				r[2] = a[2] ^ b[2]
+				r[3] = a[3] ^ b[3]
+			}
+		}
+	}
+	return m
+}
+
+func (m *xoshiroMatrix) apply(s *Xoshiro256ss) {
+	var s0, s1, s2, s3 uint64
+	for i := 0; i < 32; i++ {
+		r := &m[i][s[i/8]>>(uint(i%8)*8)&0xff]
+		s0 ^= r[0]
+		s1 ^= r[1]
+		s2 ^= r[2]
+		s3 ^= r[3]
+	}
+	s[0] = s0
+	s[1] = s1
+	s[2] = s2
+	s[3] = s3
+}
+
+var (
+	jumpMatrixOnce     sync.Once
+	jumpMatrix         *xoshiroMatrix
+	longjumpMatrixOnce sync.Once
+	longjumpMatrix     *xoshiroMatrix
+)
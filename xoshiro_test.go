@@ -0,0 +1,100 @@
+package rng_test
+
+import (
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+func TestXoshiro256ssJumpPoly(t *testing.T) {
+	// Must reproduce the reference jump and long-jump polynomials.
+	table := []struct {
+		d, want [4]uint64
+	}{
+		{[4]uint64{0, 0, 1, 0}, [4]uint64{
+			0x180ec6d33cfd0aba, 0xd5a61266f0c9392c,
+			0xa9582618e03fc9aa, 0x39abdc4529b1661c,
+		}},
+		{[4]uint64{0, 0, 0, 1}, [4]uint64{
+			0x76e15d3efefdcbbf, 0xc5004e441c522fb3,
+			0x77710069854ee241, 0x39109bb02acbe635,
+		}},
+	}
+	for _, e := range table {
+		got := rng.Xoshiro256ssJumpPoly(e.d)
+		if got != e.want {
+			t.Errorf("Xoshiro256ssJumpPoly(%#x), got %#016x, want %#016x",
+				e.d, got, e.want)
+		}
+	}
+}
+
+func TestXoshiro256ssAdvance(t *testing.T) {
+	for _, n := range []uint64{0, 1, 2, 3, 255, 256, 257, 1000} {
+		a := rng.Xoshiro256ss{1, 2, 3, 4}
+		b := a
+		for i := uint64(0); i < n; i++ {
+			a.Uint64()
+		}
+		b.Advance([4]uint64{n})
+		if a != b {
+			t.Errorf("Xoshiro256ss.Advance(%d), got %#x, want %#x",
+				n, b, a)
+		}
+	}
+
+	// The period is 2^256 - 1.
+	a := rng.Xoshiro256ss{1, 2, 3, 4}
+	b := a
+	b.Advance([4]uint64{1<<64 - 1, 1<<64 - 1, 1<<64 - 1, 1<<64 - 1})
+	if a != b {
+		t.Errorf("Xoshiro256ss.Advance(2^256-1), got %#x, want %#x", b, a)
+	}
+}
+
+func BenchmarkXoshiro256ssJumpPoly(b *testing.B) {
+	d := [4]uint64{0x0123456789abcdef, 0xfedcba9876543210, 1, 2}
+	for i := 0; i < b.N; i++ {
+		rng.Xoshiro256ssJumpPoly(d)
+	}
+}
+
+func TestXoshiro256ssJumpMatrix(t *testing.T) {
+	// The table-driven jumps must agree with stepping the generator.
+	jump := rng.Xoshiro256ssJumpPoly([4]uint64{0, 0, 1, 0})
+	longjump := rng.Xoshiro256ssJumpPoly([4]uint64{0, 0, 0, 1})
+	var r rng.Xoshiro256ss
+	r.Seed(1)
+	for i := 0; i < 100; i++ {
+		a, b := r, r
+		a.Jump()
+		b.JumpPoly(jump)
+		if a != b {
+			t.Fatalf("Xoshiro256ss.Jump(), got %#x, want %#x", a, b)
+		}
+		a, b = r, r
+		a.LongJump()
+		b.JumpPoly(longjump)
+		if a != b {
+			t.Fatalf("Xoshiro256ss.LongJump(), got %#x, want %#x", a, b)
+		}
+		r.Uint64()
+	}
+}
+
+func BenchmarkXoshiro256ssJump(b *testing.B) {
+	var r rng.Xoshiro256ss
+	r.Seed(int64(b.N))
+	for i := 0; i < b.N; i++ {
+		r.Jump()
+	}
+}
+
+func BenchmarkXoshiro256ssJumpPolyApply(b *testing.B) {
+	jump := rng.Xoshiro256ssJumpPoly([4]uint64{0, 0, 1, 0})
+	var r rng.Xoshiro256ss
+	r.Seed(int64(b.N))
+	for i := 0; i < b.N; i++ {
+		r.JumpPoly(jump)
+	}
+}
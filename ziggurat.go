@@ -0,0 +1,99 @@
+// This is free and unencumbered software released into the public domain.
+
+package rng
+
+import (
+	"math"
+)
+
+// The ziggurat samplers follow Marsaglia and Tsang, "The Ziggurat
+// Method for Generating Random Variables" (2000), with 256 layers and
+// 64-bit outputs: the low 8 bits of an output select the layer and the
+// top 52 bits provide the uniform coordinate, so most samples cost a
+// single output and a table lookup.
+
+const (
+	zigNormR = 3.6541528853610088 // start of the normal tail
+	zigExpR  = 7.69711747013104972
+)
+
+// zigTables computes layer edges x and unnormalized densities f for a
+// decreasing density pdf with tail starting at r, where v is the area
+// of each layer. x[0] is the width of the base layer's rectangle of
+// equal area, and x[256] is zero.
+func zigTables(pdf, inv func(float64) float64, r, v float64) (x, f [257]float64) {
+	x[0] = v / pdf(r)
+	x[1] = r
+	for i := 1; i < 255; i++ {
+		x[i+1] = inv(v/x[i] + pdf(x[i]))
+	}
+	for i := range x {
+		f[i] = pdf(x[i])
+	}
+	return
+}
+
+func normPdf(x float64) float64 { return math.Exp(-x * x / 2) }
+func normInv(y float64) float64 { return math.Sqrt(-2 * math.Log(y)) }
+func expPdf(x float64) float64  { return math.Exp(-x) }
+func expInv(y float64) float64  { return -math.Log(y) }
+
+var (
+	zigNormX, zigNormF = zigTables(normPdf, normInv, zigNormR,
+		zigNormR*normPdf(zigNormR)+
+			math.Sqrt(math.Pi/2)*math.Erfc(zigNormR/math.Sqrt2))
+	zigExpX, zigExpF = zigTables(expPdf, expInv, zigExpR,
+		(zigExpR+1)*expPdf(zigExpR))
+)
+
+// Normal returns a standard normally distributed pseudo-random number
+// (mean 0, standard deviation 1) using a 64-bit ziggurat.
+func Normal[G Generator](g G) float64 {
+	for {
+		b := g.Uint64()
+		i := b & 0xff
+		u := float64(int64(b)>>11) * 0x1p-52 // in [-1, 1)
+		x := u * zigNormX[i]
+		if math.Abs(x) < zigNormX[i+1] {
+			return x
+		}
+		if i == 0 {
+			// Sample from the tail beyond zigNormR.
+			for {
+				x := -math.Log(OpenFloat64(g)) / zigNormR
+				y := -math.Log(OpenFloat64(g))
+				if 2*y >= x*x {
+					if u < 0 {
+						return -zigNormR - x
+					}
+					return zigNormR + x
+				}
+			}
+		}
+		f := zigNormF[i+1] + (zigNormF[i]-zigNormF[i+1])*UnitFloat64(g)
+		if f < normPdf(x) {
+			return x
+		}
+	}
+}
+
+// Exponential returns an exponentially distributed pseudo-random
+// number with rate 1 (mean 1) using a 64-bit ziggurat.
+func Exponential[G Generator](g G) float64 {
+	for {
+		b := g.Uint64()
+		i := b & 0xff
+		x := float64(b>>12) * 0x1p-52 * zigExpX[i]
+		if x < zigExpX[i+1] {
+			return x
+		}
+		if i == 0 {
+			// The tail is itself exponential, shifted by zigExpR.
+			return zigExpR - math.Log(OpenFloat64(g))
+		}
+		f := zigExpF[i+1] + (zigExpF[i]-zigExpF[i+1])*UnitFloat64(g)
+		if f < expPdf(x) {
+			return x
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package rng_test
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"nullprogram.com/x/rng"
+)
+
+// ksStatistic returns the Kolmogorov-Smirnov statistic of samples
+// against the continuous distribution function cdf. It sorts samples.
+func ksStatistic(samples []float64, cdf func(float64) float64) float64 {
+	sort.Float64s(samples)
+	n := float64(len(samples))
+	var d float64
+	for i, x := range samples {
+		p := cdf(x)
+		d = math.Max(d, math.Max(p-float64(i)/n, float64(i+1)/n-p))
+	}
+	return d
+}
+
+// ksOK reports whether statistic d over n samples passes at roughly
+// the 0.1% significance level.
+func ksOK(d float64, n int) bool {
+	return d < 1.95/math.Sqrt(float64(n))
+}
+
+func normalCdf(x float64) float64 {
+	return math.Erfc(-x/math.Sqrt2) / 2
+}
+
+func exponentialCdf(x float64) float64 {
+	return -math.Expm1(-x)
+}
+
+func TestNormal(t *testing.T) {
+	const n = 1000000
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	samples := make([]float64, n)
+	var sum, sum2 float64
+	for i := range samples {
+		x := rng.Normal(r)
+		samples[i] = x
+		sum += x
+		sum2 += x * x
+	}
+	if mean := sum / n; math.Abs(mean) > 5/math.Sqrt(n) {
+		t.Errorf("Normal() mean, got %v", mean)
+	}
+	if v := sum2 / n; math.Abs(v-1) > 5*math.Sqrt(2.0/n) {
+		t.Errorf("Normal() variance, got %v", v)
+	}
+	if d := ksStatistic(samples, normalCdf); !ksOK(d, n) {
+		t.Errorf("Normal() KS statistic, got %v", d)
+	}
+
+	// Tail samples come from a separate path; check them alone.
+	var tail []float64
+	for len(tail) < 2000 {
+		if x := rng.Normal(r); x > 3.6541528853610088 {
+			tail = append(tail, x)
+		}
+	}
+	p0 := normalCdf(3.6541528853610088)
+	d := ksStatistic(tail, func(x float64) float64 {
+		return (normalCdf(x) - p0) / (1 - p0)
+	})
+	if !ksOK(d, len(tail)) {
+		t.Errorf("Normal() tail KS statistic, got %v", d)
+	}
+}
+
+func TestExponential(t *testing.T) {
+	const n = 1000000
+	r := rng.NewSfc64(1, 2, 3)
+	samples := make([]float64, n)
+	var sum float64
+	for i := range samples {
+		x := rng.Exponential(r)
+		if x < 0 {
+			t.Fatalf("Exponential(), got %v", x)
+		}
+		samples[i] = x
+		sum += x
+	}
+	if mean := sum / n; math.Abs(mean-1) > 5/math.Sqrt(n) {
+		t.Errorf("Exponential() mean, got %v", mean)
+	}
+	if d := ksStatistic(samples, exponentialCdf); !ksOK(d, n) {
+		t.Errorf("Exponential() KS statistic, got %v", d)
+	}
+}
+
+func BenchmarkNormal(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for i := 0; i < b.N; i++ {
+		rng.Normal(r)
+	}
+}
+
+func BenchmarkNormalMathRand(b *testing.B) {
+	r := rand.New(rng.NewXoshiro256ss(1, 2, 3, 4))
+	for i := 0; i < b.N; i++ {
+		r.NormFloat64()
+	}
+}
+
+func BenchmarkExponential(b *testing.B) {
+	r := rng.NewXoshiro256ss(1, 2, 3, 4)
+	for i := 0; i < b.N; i++ {
+		rng.Exponential(r)
+	}
+}
+
+func BenchmarkExponentialMathRand(b *testing.B) {
+	r := rand.New(rng.NewXoshiro256ss(1, 2, 3, 4))
+	for i := 0; i < b.N; i++ {
+		r.ExpFloat64()
+	}
+}